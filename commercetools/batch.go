@@ -0,0 +1,155 @@
+package commercetools
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+// updateBatchRetries is the number of times a batch is retried when the
+// parent was modified between reading and updating it
+const updateBatchRetries = 3
+
+// batchBuildFunc returns the update actions of a single child resource. It
+// receives the current parent and the actions of the other children in the
+// batch, so it can take their changes into account.
+type batchBuildFunc func(parent interface{}, pending []interface{}) ([]interface{}, error)
+
+// updateBatchHandler reads and updates the parent resource of a batch
+type updateBatchHandler struct {
+	fetch func() (interface{}, error)
+	apply func(parent interface{}, actions []interface{}) (interface{}, error)
+}
+
+// updateBatcher collects the update actions of sibling resources which share
+// a parent resource, for example the rates of a shipping method, and performs
+// them in a single update of the parent. Requests for the same parent which
+// are submitted within the window of the first request end up in the same
+// batch.
+type updateBatcher struct {
+	window  time.Duration
+	mutex   sync.Mutex
+	batches map[string]*updateBatch
+}
+
+type updateBatch struct {
+	key      string
+	handler  updateBatchHandler
+	requests []*batchRequest
+}
+
+type batchRequest struct {
+	build  batchBuildFunc
+	result interface{}
+	err    error
+	done   chan struct{}
+}
+
+func newUpdateBatcher(window time.Duration) *updateBatcher {
+	return &updateBatcher{
+		window:  window,
+		batches: make(map[string]*updateBatch),
+	}
+}
+
+// submit adds the actions of a child resource to the batch of the parent and
+// waits until the batch is performed. The result is the parent as returned
+// by the handler, from which the child can resolve its own state.
+func (b *updateBatcher) submit(key string, handler updateBatchHandler, build batchBuildFunc) (interface{}, error) {
+	request := &batchRequest{
+		build: build,
+		done:  make(chan struct{}),
+	}
+
+	b.mutex.Lock()
+	batch, ok := b.batches[key]
+	if !ok {
+		batch = &updateBatch{key: key, handler: handler}
+		b.batches[key] = batch
+		time.AfterFunc(b.window, func() { b.flush(key) })
+	}
+	batch.requests = append(batch.requests, request)
+	b.mutex.Unlock()
+
+	<-request.done
+	return request.result, request.err
+}
+
+func (b *updateBatcher) flush(key string) {
+	b.mutex.Lock()
+	batch := b.batches[key]
+	delete(b.batches, key)
+	b.mutex.Unlock()
+
+	if batch != nil {
+		batch.run()
+	}
+}
+
+func (batch *updateBatch) run() {
+	// Lock to prevent concurrent updates of the parent by other resources
+	ctMutexKV.Lock(batch.key)
+	defer ctMutexKV.Unlock(batch.key)
+
+	var result interface{}
+	var err error
+	var requests []*batchRequest
+
+	for attempt := 1; attempt <= updateBatchRetries; attempt++ {
+		result, requests, err = batch.perform()
+		if !isConcurrentModification(err) {
+			break
+		}
+		log.Printf("[DEBUG] %s was modified during the batch update, retrying", batch.key)
+	}
+
+	for _, request := range requests {
+		request.result = result
+		request.err = err
+		close(request.done)
+	}
+}
+
+// perform reads the parent, collects the actions of all requests and updates
+// the parent. Requests which fail to build their actions are completed with
+// their own error, the remaining requests are returned.
+func (batch *updateBatch) perform() (interface{}, []*batchRequest, error) {
+	parent, err := batch.handler.fetch()
+	if err != nil {
+		return nil, batch.requests, err
+	}
+
+	actions := []interface{}{}
+	valid := []*batchRequest{}
+	for _, request := range batch.requests {
+		requestActions, err := request.build(parent, actions)
+		if err != nil {
+			request.err = err
+			close(request.done)
+			continue
+		}
+		actions = append(actions, requestActions...)
+		valid = append(valid, request)
+	}
+	batch.requests = valid
+
+	if len(actions) == 0 {
+		return parent, valid, nil
+	}
+
+	log.Printf(
+		"[DEBUG] Performing %d actions of %d resources in a single update of %s",
+		len(actions), len(valid), batch.key)
+
+	result, err := batch.handler.apply(parent, actions)
+	return result, valid, err
+}
+
+func isConcurrentModification(err error) bool {
+	if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+		return ctErr.StatusCode == 409
+	}
+	return false
+}
@@ -0,0 +1,86 @@
+package commercetools
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdateBatcherCombinesActions(t *testing.T) {
+	batcher := newUpdateBatcher(50 * time.Millisecond)
+
+	var mutex sync.Mutex
+	fetches := 0
+	applied := [][]interface{}{}
+
+	handler := updateBatchHandler{
+		fetch: func() (interface{}, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			fetches++
+			return "parent", nil
+		},
+		apply: func(parent interface{}, actions []interface{}) (interface{}, error) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			applied = append(applied, actions)
+			return "updated", nil
+		},
+	}
+
+	var wg sync.WaitGroup
+	results := make([]interface{}, 3)
+	errs := make([]error, 3)
+	for i := 0; i < 3; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], errs[i] = batcher.submit("parent-id", handler, func(parent interface{}, pending []interface{}) ([]interface{}, error) {
+				if i == 1 {
+					return nil, fmt.Errorf("invalid")
+				}
+				return []interface{}{fmt.Sprintf("action-%d", i)}, nil
+			})
+		}(i)
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, fetches)
+	assert.Len(t, applied, 1)
+	assert.ElementsMatch(t, []interface{}{"action-0", "action-2"}, applied[0])
+
+	assert.NoError(t, errs[0])
+	assert.EqualError(t, errs[1], "invalid")
+	assert.NoError(t, errs[2])
+	assert.Equal(t, "updated", results[0])
+	assert.Nil(t, results[1])
+	assert.Equal(t, "updated", results[2])
+}
+
+func TestUpdateBatcherRetriesConcurrentModification(t *testing.T) {
+	batcher := newUpdateBatcher(time.Millisecond)
+
+	version := 0
+	handler := updateBatchHandler{
+		fetch: func() (interface{}, error) {
+			version++
+			return version, nil
+		},
+		apply: func(parent interface{}, actions []interface{}) (interface{}, error) {
+			if parent.(int) == 1 {
+				return nil, commercetools.ErrorResponse{StatusCode: 409}
+			}
+			return parent, nil
+		},
+	}
+
+	result, err := batcher.submit("parent-id", handler, func(parent interface{}, pending []interface{}) ([]interface{}, error) {
+		return []interface{}{"action"}, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result)
+}
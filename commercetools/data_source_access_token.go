@@ -0,0 +1,88 @@
+package commercetools
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+func dataSourceAccessToken() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceAccessTokenRead,
+		Schema: map[string]*schema.Schema{
+			"client_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"client_secret": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"scope": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"access_token": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"token_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"granted_scope": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Computed: true,
+			},
+			"expires_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceAccessTokenRead(d *schema.ResourceData, m interface{}) error {
+	config := m.(*providerConfig)
+	clientID := d.Get("client_id").(string)
+
+	scopes := expandStringArray(d.Get("scope").(*schema.Set).List())
+	sort.Strings(scopes)
+
+	oauth2Config := &clientcredentials.Config{
+		ClientID:     clientID,
+		ClientSecret: d.Get("client_secret").(string),
+		Scopes:       scopes,
+		TokenURL:     fmt.Sprintf("%s/oauth/token", config.tokenURL),
+	}
+
+	log.Printf("[DEBUG] Requesting access token for client %s with scopes %v", clientID, scopes)
+	token, err := oauth2Config.Token(context.Background())
+	if err != nil {
+		return err
+	}
+
+	// The commercetools token endpoint returns the granted scopes as a
+	// space separated string
+	grantedScopes := []string{}
+	if scope, ok := token.Extra("scope").(string); ok && scope != "" {
+		grantedScopes = strings.Split(scope, " ")
+	}
+
+	d.SetId(clientID)
+	d.Set("access_token", token.AccessToken)
+	d.Set("token_type", token.TokenType)
+	d.Set("granted_scope", grantedScopes)
+	d.Set("expires_at", token.Expiry.UTC().Format(time.RFC3339))
+	return nil
+}
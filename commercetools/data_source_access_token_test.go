@@ -0,0 +1,51 @@
+package commercetools
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccAccessTokenDataSource_basic(t *testing.T) {
+	projectKey := os.Getenv("CTP_PROJECT_KEY")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAccessTokenDataSourceConfig(projectKey),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(
+						"data.commercetools_access_token.smoke", "access_token",
+					),
+					resource.TestCheckResourceAttrSet(
+						"data.commercetools_access_token.smoke", "expires_at",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_access_token.smoke", "token_type", "Bearer",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_access_token.smoke", "granted_scope.#", "1",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccAccessTokenDataSourceConfig(projectKey string) string {
+	return fmt.Sprintf(`
+	resource "commercetools_api_client" "smoke" {
+		name = "smoke-tests"
+		scope = ["view_products:%[1]s", "view_orders:%[1]s"]
+	}
+
+	data "commercetools_access_token" "smoke" {
+		client_id = commercetools_api_client.smoke.id
+		client_secret = commercetools_api_client.smoke.secret
+		scope = ["view_products:%[1]s"]
+	}`, projectKey)
+}
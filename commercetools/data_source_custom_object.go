@@ -0,0 +1,175 @@
+package commercetools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+func dataSourceCustomObject() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceCustomObjectRead,
+		Schema: map[string]*schema.Schema{
+			"container": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"list_all": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"last_modified_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"objects": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"key": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"version": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"created_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_modified_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceCustomObjectRead(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+	container := d.Get("container").(string)
+	key := d.Get("key").(string)
+	listAll := d.Get("list_all").(bool)
+
+	if key == "" && !listAll {
+		return errors.New("Either key or list_all needs to be set")
+	}
+
+	if key != "" {
+		log.Printf("[DEBUG] Reading custom object %s/%s from commercetools", container, key)
+		customObject, err := client.CustomObjectGetWithContainerAndKey(context.Background(), container, key)
+		if err != nil {
+			return err
+		}
+
+		value, err := flattenCustomObjectValue(customObject.Value)
+		if err != nil {
+			return err
+		}
+
+		d.SetId(customObject.ID)
+		d.Set("value", value)
+		d.Set("version", customObject.Version)
+		d.Set("created_at", customObject.CreatedAt.Format(time.RFC3339))
+		d.Set("last_modified_at", customObject.LastModifiedAt.Format(time.RFC3339))
+	} else {
+		d.SetId(container)
+	}
+
+	if listAll {
+		customObjects, err := dataSourceCustomObjectQueryContainer(client, container)
+		if err != nil {
+			return err
+		}
+
+		objects := make([]map[string]interface{}, len(customObjects))
+		for i, customObject := range customObjects {
+			value, err := flattenCustomObjectValue(customObject.Value)
+			if err != nil {
+				return err
+			}
+			objects[i] = map[string]interface{}{
+				"id":               customObject.ID,
+				"key":              customObject.Key,
+				"value":            value,
+				"version":          customObject.Version,
+				"created_at":       customObject.CreatedAt.Format(time.RFC3339),
+				"last_modified_at": customObject.LastModifiedAt.Format(time.RFC3339),
+			}
+		}
+		if err := d.Set("objects", objects); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dataSourceCustomObjectQueryContainer pages through all the custom objects in
+// the given container.
+func dataSourceCustomObjectQueryContainer(client *commercetools.Client, container string) ([]commercetools.CustomObject, error) {
+	result := []commercetools.CustomObject{}
+	queryInput := commercetools.QueryInput{
+		Where: fmt.Sprintf("container=%q", container),
+		Sort:  []string{"key asc"},
+		Limit: 500,
+	}
+
+	for {
+		response, err := client.CustomObjectQuery(context.Background(), &queryInput)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, response.Results...)
+
+		if response.Count < queryInput.Limit {
+			break
+		}
+		queryInput.Offset += response.Count
+	}
+	return result, nil
+}
+
+func flattenCustomObjectValue(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,71 @@
+package commercetools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccCustomObjectDataSource_basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCustomObjectDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.commercetools_custom_object.settings", "value", "{\"enabled\":true,\"limit\":10}",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_custom_object.settings", "version", "1",
+					),
+					resource.TestCheckResourceAttrSet(
+						"data.commercetools_custom_object.settings", "created_at",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_custom_object.all", "objects.#", "2",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_custom_object.all", "objects.0.key", "checkout",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_custom_object.all", "objects.1.key", "feature-flags",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccCustomObjectDataSourceConfig() string {
+	return `
+	resource "commercetools_custom_object" "flags" {
+		container = "datasource-test"
+		key = "feature-flags"
+		value = jsonencode({
+			enabled = true
+			limit = 10
+		})
+	}
+
+	resource "commercetools_custom_object" "checkout" {
+		container = "datasource-test"
+		key = "checkout"
+		value = jsonencode({
+			guest = false
+		})
+	}
+
+	data "commercetools_custom_object" "settings" {
+		container = commercetools_custom_object.flags.container
+		key = commercetools_custom_object.flags.key
+	}
+
+	data "commercetools_custom_object" "all" {
+		depends_on = [commercetools_custom_object.flags, commercetools_custom_object.checkout]
+		container = "datasource-test"
+		list_all = true
+	}`
+}
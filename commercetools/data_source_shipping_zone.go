@@ -0,0 +1,105 @@
+package commercetools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+func dataSourceShippingZone() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceShippingZoneRead,
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"name"},
+			},
+			"name": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"key"},
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"location": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"country": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceShippingZoneRead(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+
+	var shippingZone *commercetools.Zone
+	if key, ok := d.GetOk("key"); ok {
+		log.Printf("[DEBUG] Reading shipping zone from commercetools, with key: %s", key)
+
+		var err error
+		shippingZone, err = client.ZoneGetWithKey(context.Background(), key.(string))
+		if err != nil {
+			return err
+		}
+	} else if name, ok := d.GetOk("name"); ok {
+		log.Printf("[DEBUG] Reading shipping zone from commercetools, with name: %s", name)
+
+		queryInput := commercetools.QueryInput{
+			Where: fmt.Sprintf("name=%q", name),
+			Limit: 2,
+		}
+		result, err := client.ZoneQuery(context.Background(), &queryInput)
+		if err != nil {
+			return err
+		}
+
+		switch len(result.Results) {
+		case 0:
+			return fmt.Errorf("No shipping zone found with name %q", name)
+		case 1:
+			shippingZone = &result.Results[0]
+		default:
+			return fmt.Errorf("Multiple shipping zones found with name %q, use the key instead", name)
+		}
+	} else {
+		return errors.New("Either key or name needs to be set")
+	}
+
+	log.Print("[DEBUG] Found following shipping zone:")
+	log.Print(stringFormatObject(shippingZone))
+
+	d.SetId(shippingZone.ID)
+	d.Set("key", shippingZone.Key)
+	d.Set("name", shippingZone.Name)
+	d.Set("description", shippingZone.Description)
+	d.Set("version", shippingZone.Version)
+	if err := d.Set("location", flattenShippingZoneLocations(shippingZone.Locations)); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package commercetools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccShippingZoneDataSource_basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShippingZoneDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.commercetools_shipping_zone.by_key", "id",
+						"commercetools_shipping_zone.standard", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_shipping_zone.by_key", "location.#", "2",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_shipping_zone.by_key", "location.0.country", "DE",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_shipping_zone.by_key", "location.1.state", "Nevada",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.commercetools_shipping_zone.by_name", "id",
+						"commercetools_shipping_zone.standard", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_shipping_zone.by_name", "key", "datasource-zone",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccShippingZoneDataSourceConfig() string {
+	return `
+	resource "commercetools_shipping_zone" "standard" {
+		key = "datasource-zone"
+		name = "Data source zone"
+		description = "DE and US"
+		location {
+			country = "DE"
+		}
+		location {
+			country = "US"
+			state = "Nevada"
+		}
+	}
+
+	data "commercetools_shipping_zone" "by_key" {
+		key = commercetools_shipping_zone.standard.key
+	}
+
+	data "commercetools_shipping_zone" "by_name" {
+		name = commercetools_shipping_zone.standard.name
+	}`
+}
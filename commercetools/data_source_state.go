@@ -0,0 +1,91 @@
+package commercetools
+
+import (
+	"context"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+func dataSourceState() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceStateRead,
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     TypeLocalizedString,
+				Computed: true,
+			},
+			"description": {
+				Type:     TypeLocalizedString,
+				Computed: true,
+			},
+			"initial": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"transitions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceStateRead(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+	key := d.Get("key").(string)
+
+	log.Printf("[DEBUG] Reading state from commercetools, with key: %s", key)
+	state, err := client.StateGetWithKey(
+		context.Background(), key,
+		commercetools.WithReferenceExpansion("transitions[*]"),
+	)
+	if err != nil {
+		return err
+	}
+
+	log.Print("[DEBUG] Found following state:")
+	log.Print(stringFormatObject(state))
+
+	transitions := make([]string, 0, len(state.Transitions))
+	for _, transition := range state.Transitions {
+		if transition.Obj != nil {
+			transitions = append(transitions, transition.Obj.Key)
+		}
+	}
+	sort.Strings(transitions)
+
+	d.SetId(state.ID)
+	d.Set("version", state.Version)
+	d.Set("type", state.Type)
+	if state.Name != nil {
+		d.Set("name", *state.Name)
+	}
+	if state.Description != nil {
+		d.Set("description", *state.Description)
+	}
+	d.Set("initial", state.Initial)
+	d.Set("roles", state.Roles)
+	d.Set("transitions", transitions)
+	return nil
+}
@@ -0,0 +1,125 @@
+package commercetools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+func dataSourceStateMachine() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceStateMachineRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(commercetools.StateTypeEnumOrderState),
+					string(commercetools.StateTypeEnumLineItemState),
+					string(commercetools.StateTypeEnumProductState),
+					string(commercetools.StateTypeEnumReviewState),
+					string(commercetools.StateTypeEnumPaymentState),
+				}, false),
+			},
+			"state_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"initial_states": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"adjacency_json": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceStateMachineRead(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+	stateType := d.Get("type").(string)
+
+	states, err := dataSourceStateMachineQueryStates(client, stateType)
+	if err != nil {
+		return err
+	}
+
+	stateKeys := make(map[string]string, len(states))
+	stateIDs := make(map[string]string, len(states))
+	for _, state := range states {
+		stateKeys[state.ID] = state.Key
+		stateIDs[state.Key] = state.ID
+	}
+
+	// States without transitions can transition to any other state, these
+	// are exported as null instead of an empty list.
+	initialStates := []string{}
+	adjacency := make(map[string][]string, len(states))
+	for _, state := range states {
+		if state.Initial {
+			initialStates = append(initialStates, state.Key)
+		}
+		if state.Transitions == nil {
+			adjacency[state.Key] = nil
+			continue
+		}
+
+		transitions := make([]string, 0, len(state.Transitions))
+		for _, transition := range state.Transitions {
+			if key, ok := stateKeys[transition.ID]; ok {
+				transitions = append(transitions, key)
+			}
+		}
+		sort.Strings(transitions)
+		adjacency[state.Key] = transitions
+	}
+	sort.Strings(initialStates)
+
+	adjacencyJSON, err := json.Marshal(adjacency)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(stateType)
+	d.Set("state_ids", stateIDs)
+	d.Set("initial_states", initialStates)
+	d.Set("adjacency_json", string(adjacencyJSON))
+	return nil
+}
+
+// dataSourceStateMachineQueryStates pages through all the states of the
+// given type.
+func dataSourceStateMachineQueryStates(client *commercetools.Client, stateType string) ([]commercetools.State, error) {
+	log.Printf("[DEBUG] Reading states from commercetools, with type: %s", stateType)
+
+	result := []commercetools.State{}
+	queryInput := commercetools.QueryInput{
+		Where: fmt.Sprintf("type=%q", stateType),
+		Sort:  []string{"key asc"},
+		Limit: 500,
+	}
+
+	for {
+		response, err := client.StateQuery(context.Background(), &queryInput)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, response.Results...)
+
+		if response.Count < queryInput.Limit {
+			break
+		}
+		queryInput.Offset += response.Count
+	}
+	return result, nil
+}
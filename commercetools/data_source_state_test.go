@@ -0,0 +1,104 @@
+package commercetools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccStateDataSource_basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.commercetools_state.review_open", "id",
+						"commercetools_state.review_open", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_state.review_open", "type", "ReviewState",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_state.review_open", "name.en", "Open",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_state.review_open", "initial", "true",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_state.review_open", "roles.0", "ReviewIncludedInStatistics",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_state.review_open", "transitions.#", "1",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_state.review_open", "transitions.0", "datasource-review-closed",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStateMachineDataSource_basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStateDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.commercetools_state_machine.review", "state_ids.datasource-review-open",
+						"commercetools_state.review_open", "id",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.commercetools_state_machine.review", "state_ids.datasource-review-closed",
+						"commercetools_state.review_closed", "id",
+					),
+					resource.TestCheckOutput("closed_transitions", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccStateDataSourceConfig() string {
+	return `
+	resource "commercetools_state" "review_open" {
+		key = "datasource-review-open"
+		type = "ReviewState"
+		name = {
+			en = "Open"
+		}
+		initial = true
+		roles = ["ReviewIncludedInStatistics"]
+		transitions = [commercetools_state.review_closed.key]
+	}
+
+	resource "commercetools_state" "review_closed" {
+		key = "datasource-review-closed"
+		type = "ReviewState"
+		name = {
+			en = "Closed"
+		}
+		transitions = []
+	}
+
+	data "commercetools_state" "review_open" {
+		key = commercetools_state.review_open.key
+	}
+
+	data "commercetools_state_machine" "review" {
+		depends_on = [commercetools_state.review_open, commercetools_state.review_closed]
+		type = "ReviewState"
+	}
+
+	output "closed_transitions" {
+		value = length(jsondecode(data.commercetools_state_machine.review.adjacency_json)["datasource-review-closed"])
+	}`
+}
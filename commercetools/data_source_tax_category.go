@@ -0,0 +1,109 @@
+package commercetools
+
+import (
+	"context"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceTaxCategory() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceTaxCategoryRead,
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"rate": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"included_in_price": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"country": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"state": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"sub_rate": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"amount": {
+										Type:     schema.TypeFloat,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceTaxCategoryRead(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+	key := d.Get("key").(string)
+
+	log.Printf("[DEBUG] Reading tax category from commercetools, with key: %s", key)
+	taxCategory, err := client.TaxCategoryGetWithKey(context.Background(), key)
+	if err != nil {
+		return err
+	}
+
+	log.Print("[DEBUG] Found following tax category:")
+	log.Print(stringFormatObject(taxCategory))
+
+	rates := make([]map[string]interface{}, len(taxCategory.Rates))
+	for i := range taxCategory.Rates {
+		rates[i] = flattenTaxRate(&taxCategory.Rates[i])
+		rates[i]["id"] = taxCategory.Rates[i].ID
+	}
+
+	d.SetId(taxCategory.ID)
+	d.Set("name", taxCategory.Name)
+	d.Set("description", taxCategory.Description)
+	d.Set("version", taxCategory.Version)
+	if err := d.Set("rate", rates); err != nil {
+		return err
+	}
+	return nil
+}
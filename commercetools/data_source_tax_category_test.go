@@ -0,0 +1,67 @@
+package commercetools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccTaxCategoryDataSource_basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxCategoryDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.commercetools_tax_category.standard", "id",
+						"commercetools_tax_category.standard", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_tax_category.standard", "name", "Standard tax category",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_tax_category.standard", "rate.#", "1",
+					),
+					resource.TestCheckResourceAttrPair(
+						"data.commercetools_tax_category.standard", "rate.0.id",
+						"commercetools_tax_category_rate.standard-tax-category-DE", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_tax_category.standard", "rate.0.amount", "0.19",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_tax_category.standard", "rate.0.country", "DE",
+					),
+					resource.TestCheckResourceAttr(
+						"data.commercetools_tax_category.standard", "rate.0.included_in_price", "true",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccTaxCategoryDataSourceConfig() string {
+	return `
+	resource "commercetools_tax_category" "standard" {
+		name = "Standard tax category"
+		key = "datasource-standard"
+		description = "Example category"
+	}
+
+	resource "commercetools_tax_category_rate" "standard-tax-category-DE" {
+		tax_category_id = commercetools_tax_category.standard.id
+		name = "19% MwSt"
+		amount = 0.19
+		included_in_price = true
+		country = "DE"
+	}
+
+	data "commercetools_tax_category" "standard" {
+		depends_on = [commercetools_tax_category_rate.standard-tax-category-DE]
+		key = commercetools_tax_category.standard.key
+	}`
+}
@@ -0,0 +1,235 @@
+package commercetools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"sort"
+	"sync"
+
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+// prefetchPageSize is the number of objects requested per page, this is the
+// maximum page size of the commercetools API
+const prefetchPageSize = 500
+
+// prefetchType describes an object type which can be prefetched. The expand
+// parameters must match the reference expansion of the individual reads, so
+// both end up with the same object in the read cache.
+type prefetchType struct {
+	endpoint string
+	expand   []string
+	decode   func(data json.RawMessage) (interface{}, error)
+}
+
+// prefetchTypes are the object types which can be prefetched, by the name
+// used in the prefetch setting of the provider
+var prefetchTypes = map[string]prefetchType{
+	"categories": {
+		endpoint: "categories",
+		expand:   []string{"parent"},
+		decode: func(data json.RawMessage) (interface{}, error) {
+			var category *commercetools.Category
+			err := json.Unmarshal(data, &category)
+			return category, err
+		},
+	},
+	"shipping_methods": {
+		endpoint: "shipping-methods",
+		decode: func(data json.RawMessage) (interface{}, error) {
+			var shippingMethod *commercetools.ShippingMethod
+			err := json.Unmarshal(data, &shippingMethod)
+			return shippingMethod, err
+		},
+	},
+	"shipping_zones": {
+		endpoint: "zones",
+		decode: func(data json.RawMessage) (interface{}, error) {
+			var zone *commercetools.Zone
+			err := json.Unmarshal(data, &zone)
+			return zone, err
+		},
+	},
+	"tax_categories": {
+		endpoint: "tax-categories",
+		decode: func(data json.RawMessage) (interface{}, error) {
+			var taxCategory *commercetools.TaxCategory
+			err := json.Unmarshal(data, &taxCategory)
+			return taxCategory, err
+		},
+	},
+}
+
+// prefetchTypeNames returns the names of the object types which can be
+// prefetched
+func prefetchTypeNames() []string {
+	names := make([]string, 0, len(prefetchTypes))
+	for name := range prefetchTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// prefetcher pages through the objects of a type sorted by id and stores them
+// in the read cache, so the individual reads of the resources during a refresh
+// don't need a request per resource. The pages are only fetched up to the
+// highest id which is read, a read waits until the page holding its id is
+// fetched. Objects which are not found, for example because they were created
+// afterwards, are still read individually.
+type prefetcher struct {
+	client  *restClient
+	cache   *readCache
+	enabled map[string]bool
+	mutex   sync.Mutex
+	fetched *sync.Cond
+	cursors map[string]*prefetchCursor
+}
+
+// prefetchCursor is the progress of the prefetch of a type. All objects with
+// an id up to lastID are fetched, wantedID is the highest id which is read.
+type prefetchCursor struct {
+	lastID   string
+	wantedID string
+	running  bool
+	done     bool
+	failed   bool
+}
+
+func newPrefetcher(client *restClient, cache *readCache, names []string) *prefetcher {
+	enabled := make(map[string]bool)
+	for _, name := range names {
+		enabled[name] = true
+	}
+	p := &prefetcher{
+		client:  client,
+		cache:   cache,
+		enabled: enabled,
+		cursors: make(map[string]*prefetchCursor),
+	}
+	p.fetched = sync.NewCond(&p.mutex)
+	return p
+}
+
+// load waits until the object with the id is prefetched when this is enabled
+// in the provider. The pages are fetched in the background as long as there
+// are reads waiting for them. A failed prefetch is logged and the resources
+// fall back to individual reads.
+func (p *prefetcher) load(name string, id string) {
+	if p == nil || !p.enabled[name] {
+		return
+	}
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	cursor, ok := p.cursors[name]
+	if !ok {
+		cursor = &prefetchCursor{}
+		p.cursors[name] = cursor
+	}
+
+	for !cursor.done && !cursor.failed && (cursor.lastID == "" || id > cursor.lastID) {
+		if id > cursor.wantedID {
+			cursor.wantedID = id
+		}
+		if !cursor.running {
+			cursor.running = true
+			go p.fetchPages(name, cursor)
+		}
+		p.fetched.Wait()
+	}
+}
+
+// fetchPages fetches the pages of the type until the highest id which is
+// read is reached. The pages are selected with a predicate on the last id
+// instead of an offset, since the offset is limited by the API.
+func (p *prefetcher) fetchPages(name string, cursor *prefetchCursor) {
+	objectType := prefetchTypes[name]
+
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	for !cursor.done && (cursor.lastID == "" || cursor.wantedID > cursor.lastID) {
+		lastID := cursor.lastID
+
+		p.mutex.Unlock()
+		count, pageLastID, err := p.fetchPage(objectType, lastID)
+		p.mutex.Lock()
+
+		if err != nil {
+			log.Printf("[WARN] Unable to prefetch %s, reading them individually: %s", name, err)
+			cursor.failed = true
+			break
+		}
+		log.Printf("[DEBUG] Prefetched %d %s", count, name)
+
+		if count < prefetchPageSize {
+			cursor.done = true
+		} else {
+			cursor.lastID = pageLastID
+		}
+		p.fetched.Broadcast()
+	}
+
+	cursor.running = false
+	p.fetched.Broadcast()
+}
+
+// fetchPage reads the page of objects following the last id and stores them
+// in the read cache. It returns the number of objects and the id of the last
+// one.
+func (p *prefetcher) fetchPage(objectType prefetchType, lastID string) (int, string, error) {
+	params := url.Values{}
+	params.Set("limit", fmt.Sprint(prefetchPageSize))
+	params.Set("sort", "id asc")
+	params.Set("withTotal", "false")
+	for _, expand := range objectType.expand {
+		params.Add("expand", expand)
+	}
+	if lastID != "" {
+		params.Set("where", fmt.Sprintf("id > %q", lastID))
+	}
+
+	response := prefetchPage{}
+	err := p.client.get(context.Background(), objectType.endpoint, params, &response)
+	if err != nil {
+		return 0, "", err
+	}
+
+	for _, data := range response.Results {
+		header := prefetchObjectHeader{}
+		if err := json.Unmarshal(data, &header); err != nil {
+			return 0, "", err
+		}
+		value, err := objectType.decode(data)
+		if err != nil {
+			return 0, "", err
+		}
+		p.cache.store(readCacheKey(objectType.endpoint, header.ID), value, header.Version)
+		lastID = header.ID
+	}
+	return len(response.Results), lastID, nil
+}
+
+// readPrefetched returns the object from the read cache, after prefetching
+// the objects of its type up to its id when enabled. When the object is not
+// cached it is read with the fetch function.
+func readPrefetched(m interface{}, name string, id string, fetch func() (interface{}, int, error)) (interface{}, error) {
+	config := m.(*providerConfig)
+	config.prefetch.load(name, id)
+	return config.cache.get(readCacheKey(prefetchTypes[name].endpoint, id), fetch)
+}
+
+type prefetchPage struct {
+	Count   int               `json:"count"`
+	Results []json.RawMessage `json:"results"`
+}
+
+type prefetchObjectHeader struct {
+	ID      string `json:"id"`
+	Version int    `json:"version"`
+}
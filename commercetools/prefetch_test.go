@@ -0,0 +1,128 @@
+package commercetools
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefetchTaxCategories(t *testing.T) {
+	taxCategories := []map[string]interface{}{}
+	for i := 0; i < 1200; i++ {
+		taxCategories = append(taxCategories, map[string]interface{}{
+			"id":      fmt.Sprintf("tax-category-%04d", i),
+			"version": 1,
+			"name":    fmt.Sprintf("Tax category %d", i),
+			"rates":   []interface{}{},
+		})
+	}
+
+	var queries, reads int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/project/tax-categories":
+			atomic.AddInt32(&queries, 1)
+			assert.Equal(t, "id asc", r.URL.Query().Get("sort"))
+
+			lastID := ""
+			if where := r.URL.Query().Get("where"); where != "" {
+				lastID = strings.Trim(strings.TrimPrefix(where, "id > "), "\"")
+			}
+			limit, _ := strconv.Atoi(r.URL.Query().Get("limit"))
+
+			results := []map[string]interface{}{}
+			for _, taxCategory := range taxCategories {
+				if taxCategory["id"].(string) > lastID && len(results) < limit {
+					results = append(results, taxCategory)
+				}
+			}
+			json.NewEncoder(w).Encode(map[string]interface{}{
+				"limit":   limit,
+				"count":   len(results),
+				"results": results,
+			})
+		case strings.HasPrefix(r.URL.Path, "/project/tax-categories/"):
+			atomic.AddInt32(&reads, 1)
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"statusCode": 404, "message": "Not found"}`)
+		default:
+			t.Errorf("unexpected request to %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	rest := newRestClient(server.Client(), server.URL, "project")
+	cache := newReadCache()
+	config := &providerConfig{
+		client: commercetools.New(&commercetools.Config{
+			ProjectKey: "project",
+			URL:        server.URL,
+			HTTPClient: server.Client(),
+		}),
+		rest:     rest,
+		cache:    cache,
+		prefetch: newPrefetcher(rest, cache, []string{"tax_categories"}),
+	}
+
+	// The resources are read with a concurrency of 10, the default
+	// parallelism of terraform
+	refresh := func(ids []string, found bool) {
+		queue := make(chan string)
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for id := range queue {
+					d := schema.TestResourceDataRaw(t, resourceTaxCategory().Schema, map[string]interface{}{})
+					d.SetId(id)
+					assert.NoError(t, resourceTaxCategoryRead(d, config))
+					if found {
+						assert.Equal(t, id, d.Id())
+						assert.NotEmpty(t, d.Get("name"))
+					} else {
+						assert.Equal(t, "", d.Id())
+					}
+				}
+			}()
+		}
+		for _, id := range ids {
+			queue <- id
+		}
+		close(queue)
+		wg.Wait()
+	}
+
+	// Only the pages up to the highest id which is read are fetched
+	ids := []string{}
+	for i := 0; i < 100; i++ {
+		ids = append(ids, fmt.Sprintf("tax-category-%04d", (i*37)%500))
+	}
+	refresh(ids, true)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&queries))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&reads))
+
+	ids = []string{}
+	for i := 0; i < 300; i++ {
+		ids = append(ids, fmt.Sprintf("tax-category-%04d", (i*7)%1100))
+	}
+	refresh(ids, true)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&queries))
+	assert.Equal(t, int32(0), atomic.LoadInt32(&reads))
+
+	// Objects which are not prefetched are read individually
+	refresh([]string{"removed-tax-category"}, false)
+	assert.Equal(t, int32(3), atomic.LoadInt32(&queries))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&reads))
+}
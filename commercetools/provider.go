@@ -4,9 +4,11 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/labd/commercetools-go-sdk/commercetools"
 	"golang.org/x/oauth2/clientcredentials"
@@ -55,20 +57,53 @@ func Provider() terraform.ResourceProvider {
 				DefaultFunc: schema.EnvDefaultFunc("CTP_AUTH_URL", nil),
 				Description: "The authentication URL of the commercetools platform. https://docs.commercetools.com/http-api-authorization",
 			},
+			"prefetch": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(prefetchTypeNames(), false),
+				},
+				Description: "The object types to read in bulk with a few paginated queries, instead of a request per resource. Useful for projects with many resources of these types.",
+			},
+			"validate_project_countries": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Validate the countries of shipping zones and tax rates against the countries configured in the project during the plan.",
+			},
+		},
+		DataSourcesMap: map[string]*schema.Resource{
+			"commercetools_access_token":  dataSourceAccessToken(),
+			"commercetools_custom_object": dataSourceCustomObject(),
+			"commercetools_shipping_zone": dataSourceShippingZone(),
+			"commercetools_state":         dataSourceState(),
+			"commercetools_state_machine": dataSourceStateMachine(),
+			"commercetools_tax_category":  dataSourceTaxCategory(),
 		},
 		ResourcesMap: map[string]*schema.Resource{
 			"commercetools_api_client":         resourceAPIClient(),
 			"commercetools_api_extension":      resourceAPIExtension(),
+			"commercetools_associate_role":     resourceAssociateRole(),
+			"commercetools_attribute_group":    resourceAttributeGroup(),
+			"commercetools_business_unit":      resourceBusinessUnit(),
 			"commercetools_cart_discount":      resourceCartDiscount(),
+			"commercetools_category":           resourceCategory(),
+			"commercetools_category_tree":      resourceCategoryTree(),
 			"commercetools_channel":            resourceChannel(),
 			"commercetools_custom_object":      resourceCustomObject(),
 			"commercetools_customer_group":     resourceCustomerGroup(),
 			"commercetools_discount_code":      resourceDiscountCode(),
+			"commercetools_inventory_entry":    resourceInventoryEntry(),
+			"commercetools_product":            resourceProduct(),
+			"commercetools_product_discount":   resourceProductDiscount(),
+			"commercetools_product_selection":  resourceProductSelection(),
 			"commercetools_product_type":       resourceProductType(),
 			"commercetools_project_settings":   resourceProjectSettings(),
 			"commercetools_shipping_method":    resourceShippingMethod(),
 			"commercetools_shipping_zone_rate": resourceShippingZoneRate(),
 			"commercetools_shipping_zone":      resourceShippingZone(),
+			"commercetools_standalone_price":   resourceStandalonePrice(),
 			"commercetools_state":              resourceState(),
 			"commercetools_store":              resourceStore(),
 			"commercetools_subscription":       resourceSubscription(),
@@ -107,8 +142,36 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		ContactEmail: "opensource@labdigital.nl",
 	})
 
-	return client, nil
+	rest := newRestClient(httpClient, apiURL, projectKey)
+	cache := newReadCache()
+	prefetch := expandStringArray(d.Get("prefetch").(*schema.Set).List())
+
+	return &providerConfig{
+		client:   client,
+		rest:     rest,
+		cache:    cache,
+		prefetch: newPrefetcher(rest, cache, prefetch),
+		tokenURL: authURL,
+
+		validateProjectCountries: d.Get("validate_project_countries").(bool),
+	}, nil
+}
+
+// providerConfig is the meta value passed to all resources and data sources
+type providerConfig struct {
+	client   *commercetools.Client
+	rest     *restClient
+	cache    *readCache
+	prefetch *prefetcher
+	tokenURL string
+
+	validateProjectCountries bool
 }
 
 // This is a global MutexKV for use within this plugin.
 var ctMutexKV = mutexkv.NewMutexKV()
+
+// This is a global batcher to combine the updates of sibling resources, like
+// shipping zone rates and tax category rates, into a single update of their
+// parent.
+var ctUpdateBatcher = newUpdateBatcher(200 * time.Millisecond)
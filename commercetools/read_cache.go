@@ -0,0 +1,102 @@
+package commercetools
+
+import (
+	"fmt"
+	"log"
+	"sync"
+)
+
+// readCache holds parent resources, like shipping methods and tax categories,
+// which are read by many child resources during a single refresh. Concurrent
+// reads of the same key wait for the first read instead of fetching the
+// resource again. Entries are replaced or removed whenever the provider
+// writes the resource, so reads never return an older version than the
+// provider has written itself.
+type readCache struct {
+	mutex   sync.Mutex
+	entries map[string]*readCacheEntry
+}
+
+type readCacheEntry struct {
+	value   interface{}
+	version int
+	err     error
+	done    chan struct{}
+}
+
+// readCacheKey returns the key of an object in the read cache, which is the
+// endpoint of the object in the API
+func readCacheKey(endpoint string, id string) string {
+	return fmt.Sprintf("%s/%s", endpoint, id)
+}
+
+func newReadCache() *readCache {
+	return &readCache{
+		entries: make(map[string]*readCacheEntry),
+	}
+}
+
+// get returns the cached value for the key, or calls fetch to read it. A
+// failed fetch is not cached, so the next read will try again.
+func (c *readCache) get(key string, fetch func() (interface{}, int, error)) (interface{}, error) {
+	c.mutex.Lock()
+	entry, ok := c.entries[key]
+	if ok {
+		c.mutex.Unlock()
+		<-entry.done
+		if entry.err == nil {
+			log.Printf("[DEBUG] Using cached version %d of %s", entry.version, key)
+			return entry.value, nil
+		}
+		return c.get(key, fetch)
+	}
+
+	entry = &readCacheEntry{done: make(chan struct{})}
+	c.entries[key] = entry
+	c.mutex.Unlock()
+
+	entry.value, entry.version, entry.err = fetch()
+	if entry.err != nil {
+		c.mutex.Lock()
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+		c.mutex.Unlock()
+	}
+	close(entry.done)
+
+	return entry.value, entry.err
+}
+
+// store saves the value returned by a write of the resource. The value is
+// ignored when the cache already has a newer version.
+func (c *readCache) store(key string, value interface{}, version int) {
+	entry := &readCacheEntry{
+		value:   value,
+		version: version,
+		done:    make(chan struct{}),
+	}
+	close(entry.done)
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if current, ok := c.entries[key]; ok {
+		select {
+		case <-current.done:
+			if current.err == nil && current.version > version {
+				return
+			}
+		default:
+		}
+	}
+	c.entries[key] = entry
+}
+
+// invalidate removes the key from the cache, it is used when the resource is
+// written without knowing the resulting version
+func (c *readCache) invalidate(key string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.entries, key)
+}
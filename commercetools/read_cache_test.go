@@ -0,0 +1,139 @@
+package commercetools
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadCacheTaxCategoryRates(t *testing.T) {
+	var requests int32
+	var mutex sync.Mutex
+	version := 3
+	name := "Standard"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/project/tax-categories/tax-category-id" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		switch r.Method {
+		case http.MethodGet:
+			atomic.AddInt32(&requests, 1)
+		case http.MethodPost:
+			input := struct {
+				Version int `json:"version"`
+				Actions []struct {
+					Action string `json:"action"`
+					Name   string `json:"name"`
+				} `json:"actions"`
+			}{}
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&input))
+			assert.Equal(t, version, input.Version)
+			for _, action := range input.Actions {
+				if action.Action == "changeName" {
+					name = action.Name
+				}
+			}
+			version++
+		default:
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{
+			"id": "tax-category-id",
+			"version": %d,
+			"name": %q,
+			"rates": [
+				{"id": "rate-de", "name": "DE", "amount": 0.19, "includedInPrice": true, "country": "DE"},
+				{"id": "rate-nl", "name": "NL", "amount": 0.21, "includedInPrice": true, "country": "NL"},
+				{"id": "rate-fr", "name": "FR", "amount": 0.2, "includedInPrice": true, "country": "FR"}
+			]
+		}`, version, name)
+	}))
+	defer server.Close()
+
+	config := &providerConfig{
+		client: commercetools.New(&commercetools.Config{
+			ProjectKey: "project",
+			URL:        server.URL,
+			HTTPClient: server.Client(),
+		}),
+		rest:  newRestClient(server.Client(), server.URL, "project"),
+		cache: newReadCache(),
+	}
+
+	refresh := func() {
+		var wg sync.WaitGroup
+		for _, id := range []string{"rate-de", "rate-nl", "rate-fr"} {
+			d := schema.TestResourceDataRaw(t, resourceTaxCategoryRate().Schema, map[string]interface{}{
+				"tax_category_id": "tax-category-id",
+			})
+			d.SetId(id)
+
+			wg.Add(1)
+			go func(d *schema.ResourceData) {
+				defer wg.Done()
+				assert.NoError(t, resourceTaxCategoryRateRead(d, config))
+				assert.NotEmpty(t, d.Id())
+				assert.NotEmpty(t, d.Get("country"))
+			}(d)
+		}
+		wg.Wait()
+	}
+
+	refresh()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	refresh()
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+
+	// An update of the tax category invalidates the cached version, the read
+	// after the update fetches the new version which is then used by the
+	// next refresh
+	d := schema.TestResourceDataRaw(t, resourceTaxCategory().Schema, map[string]interface{}{
+		"name": "Reduced",
+	})
+	d.SetId("tax-category-id")
+	assert.NoError(t, resourceTaxCategoryUpdate(d, config))
+	assert.Equal(t, 4, d.Get("version"))
+	assert.Equal(t, "Reduced", d.Get("name"))
+	requestsAfterUpdate := atomic.LoadInt32(&requests)
+
+	refresh()
+	assert.Equal(t, requestsAfterUpdate, atomic.LoadInt32(&requests))
+	taxCategory, err := readTaxCategory(config, "tax-category-id")
+	assert.NoError(t, err)
+	assert.Equal(t, 4, taxCategory.Version)
+}
+
+func TestReadCacheStoreKeepsNewerVersion(t *testing.T) {
+	cache := newReadCache()
+	fetch := func() (interface{}, int, error) {
+		t.Fatal("unexpected fetch")
+		return nil, 0, nil
+	}
+
+	cache.store("key", "version 2", 2)
+	cache.store("key", "version 1", 1)
+	value, err := cache.get("key", fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, "version 2", value)
+
+	cache.store("key", "version 3", 3)
+	value, err = cache.get("key", fetch)
+	assert.NoError(t, err)
+	assert.Equal(t, "version 3", value)
+}
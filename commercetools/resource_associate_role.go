@@ -0,0 +1,257 @@
+package commercetools
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+// associateRolePermissions are the permissions which can be granted to an
+// associate role
+var associateRolePermissions = []string{
+	"AddChildUnits",
+	"CreateMyCarts",
+	"CreateMyOrdersFromMyCarts",
+	"CreateMyOrdersFromMyQuotes",
+	"CreateMyQuoteRequestsFromMyCarts",
+	"CreateOrdersFromOthersCarts",
+	"CreateOrdersFromOthersQuotes",
+	"CreateOthersCarts",
+	"CreateQuoteRequestsFromOthersCarts",
+	"DeclineMyQuotes",
+	"DeclineOthersQuotes",
+	"DeleteMyCarts",
+	"DeleteOthersCarts",
+	"ReassignMyQuotes",
+	"ReassignOthersQuotes",
+	"RenegotiateMyQuotes",
+	"RenegotiateOthersQuotes",
+	"UpdateAssociates",
+	"UpdateBusinessUnitDetails",
+	"UpdateMyCarts",
+	"UpdateMyOrders",
+	"UpdateMyQuoteRequests",
+	"UpdateOthersCarts",
+	"UpdateOthersOrders",
+	"UpdateOthersQuoteRequests",
+	"UpdateParentUnit",
+	"ViewMyCarts",
+	"ViewMyOrders",
+	"ViewMyQuoteRequests",
+	"ViewMyQuotes",
+	"ViewOthersCarts",
+	"ViewOthersOrders",
+	"ViewOthersQuoteRequests",
+	"ViewOthersQuotes",
+}
+
+// Associate roles are not available in the commercetools-go-sdk, so this
+// resource uses the rest client with the types defined at the bottom of this
+// file.
+func resourceAssociateRole() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAssociateRoleCreate,
+		Read:   resourceAssociateRoleRead,
+		Update: resourceAssociateRoleUpdate,
+		Delete: resourceAssociateRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"buyer_assignable": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"permissions": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(associateRolePermissions, false),
+				},
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceAssociateRoleCreate(d *schema.ResourceData, m interface{}) error {
+	client := getRestClient(m)
+	var role *associateRole
+
+	draft := &associateRoleDraft{
+		Key:             d.Get("key").(string),
+		Name:            d.Get("name").(string),
+		BuyerAssignable: d.Get("buyer_assignable").(bool),
+		Permissions:     expandAssociateRolePermissions(d.Get("permissions").(*schema.Set)),
+	}
+
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		role = &associateRole{}
+		err := client.create(context.Background(), "associate-roles", draft, role)
+		if err != nil {
+			return handleCommercetoolsError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(role.ID)
+	d.Set("version", role.Version)
+
+	return resourceAssociateRoleRead(d, m)
+}
+
+func resourceAssociateRoleRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Reading associate role from commercetools, with associate role id: %s", d.Id())
+	client := getRestClient(m)
+
+	role := &associateRole{}
+	err := client.get(context.Background(), fmt.Sprintf("associate-roles/%s", d.Id()), nil, role)
+
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			if ctErr.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	log.Print("[DEBUG] Found following associate role:")
+	log.Print(stringFormatObject(role))
+
+	d.Set("version", role.Version)
+	d.Set("key", role.Key)
+	d.Set("name", role.Name)
+	d.Set("buyer_assignable", role.BuyerAssignable)
+	d.Set("permissions", role.Permissions)
+
+	return nil
+}
+
+func resourceAssociateRoleUpdate(d *schema.ResourceData, m interface{}) error {
+	client := getRestClient(m)
+
+	actions := []interface{}{}
+
+	if d.HasChange("name") {
+		actions = append(actions, associateRoleSetNameAction{
+			Action: "setName",
+			Name:   d.Get("name").(string),
+		})
+	}
+
+	if d.HasChange("buyer_assignable") {
+		actions = append(actions, associateRoleChangeBuyerAssignableAction{
+			Action:          "changeBuyerAssignable",
+			BuyerAssignable: d.Get("buyer_assignable").(bool),
+		})
+	}
+
+	if d.HasChange("permissions") {
+		old, new := d.GetChange("permissions")
+		oldPermissions := old.(*schema.Set)
+		newPermissions := new.(*schema.Set)
+
+		for _, permission := range expandAssociateRolePermissions(oldPermissions.Difference(newPermissions)) {
+			actions = append(actions, associateRolePermissionAction{
+				Action:     "removePermission",
+				Permission: permission,
+			})
+		}
+		for _, permission := range expandAssociateRolePermissions(newPermissions.Difference(oldPermissions)) {
+			actions = append(actions, associateRolePermissionAction{
+				Action:     "addPermission",
+				Permission: permission,
+			})
+		}
+	}
+
+	log.Printf(
+		"[DEBUG] Will perform update operation with the following actions:\n%s",
+		stringFormatActions(actions))
+
+	err := client.update(
+		context.Background(), fmt.Sprintf("associate-roles/%s", d.Id()),
+		d.Get("version").(int), actions, nil)
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
+		}
+		return err
+	}
+
+	return resourceAssociateRoleRead(d, m)
+}
+
+func resourceAssociateRoleDelete(d *schema.ResourceData, m interface{}) error {
+	client := getRestClient(m)
+	version := d.Get("version").(int)
+	err := client.delete(context.Background(), fmt.Sprintf("associate-roles/%s", d.Id()), version, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func expandAssociateRolePermissions(input *schema.Set) []string {
+	permissions := expandStringArray(input.List())
+	sort.Strings(permissions)
+	return permissions
+}
+
+type associateRole struct {
+	ID              string   `json:"id"`
+	Version         int      `json:"version"`
+	Key             string   `json:"key"`
+	Name            string   `json:"name,omitempty"`
+	BuyerAssignable bool     `json:"buyerAssignable"`
+	Permissions     []string `json:"permissions"`
+}
+
+type associateRoleDraft struct {
+	Key             string   `json:"key"`
+	Name            string   `json:"name,omitempty"`
+	BuyerAssignable bool     `json:"buyerAssignable"`
+	Permissions     []string `json:"permissions"`
+}
+
+type associateRoleSetNameAction struct {
+	Action string `json:"action"`
+	Name   string `json:"name,omitempty"`
+}
+
+type associateRoleChangeBuyerAssignableAction struct {
+	Action          string `json:"action"`
+	BuyerAssignable bool   `json:"buyerAssignable"`
+}
+
+type associateRolePermissionAction struct {
+	Action     string `json:"action"`
+	Permission string `json:"permission"`
+}
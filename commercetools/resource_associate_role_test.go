@@ -0,0 +1,66 @@
+package commercetools
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccAssociateRole_createAndUpdate(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAssociateRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAssociateRoleConfig("Buyer", "true", `"ViewMyCarts", "UpdateMyCarts"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_associate_role.buyer", "key", "buyer",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_associate_role.buyer", "name", "Buyer",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_associate_role.buyer", "buyer_assignable", "true",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_associate_role.buyer", "permissions.#", "2",
+					),
+				),
+			},
+			{
+				Config: testAccAssociateRoleConfig("Purchaser", "false", `"ViewMyCarts", "CreateMyCarts", "ViewMyOrders"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_associate_role.buyer", "name", "Purchaser",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_associate_role.buyer", "buyer_assignable", "false",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_associate_role.buyer", "permissions.#", "3",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccAssociateRoleConfig(name string, buyerAssignable string, permissions string) string {
+	return fmt.Sprintf(`
+	resource "commercetools_associate_role" "buyer" {
+		key              = "buyer"
+		name             = "%s"
+		buyer_assignable = %s
+		permissions      = [%s]
+	}
+	`, name, buyerAssignable, permissions)
+}
+
+func testAccCheckAssociateRoleDestroy(s *terraform.State) error {
+	return nil
+}
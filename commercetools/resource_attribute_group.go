@@ -0,0 +1,315 @@
+package commercetools
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+// Attribute groups are not available in the commercetools-go-sdk, so this
+// resource uses the rest client with the types defined at the bottom of this
+// file.
+func resourceAttributeGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceAttributeGroupCreate,
+		Read:   resourceAttributeGroupRead,
+		Update: resourceAttributeGroupUpdate,
+		Delete: resourceAttributeGroupDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceAttributeGroupCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     TypeLocalizedString,
+				Required: true,
+			},
+			"description": {
+				Type:     TypeLocalizedString,
+				Optional: true,
+			},
+			"attributes": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceAttributeGroupCustomizeDiff verifies that all attributes in the
+// group are defined on at least one product type in the project. When the
+// attribute names are not known yet during the plan the check is skipped.
+func resourceAttributeGroupCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("attributes") || !d.NewValueKnown("attributes") {
+		return nil
+	}
+
+	names := expandStringArray(d.Get("attributes").([]interface{}))
+	if len(names) == 0 {
+		return nil
+	}
+
+	client := getClient(m)
+	defined, err := queryProductTypeAttributeNames(client, names)
+	if err != nil {
+		return err
+	}
+
+	missing := []string{}
+	for _, name := range names {
+		if _, ok := defined[name]; !ok {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return fmt.Errorf(
+			"the following attributes are not defined on any product type: %s",
+			strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// queryProductTypeAttributeNames pages through the product types which define
+// at least one of the given attribute names and returns the names of their
+// attributes.
+func queryProductTypeAttributeNames(client *commercetools.Client, names []string) (map[string]bool, error) {
+	log.Print("[DEBUG] Reading product types from commercetools to validate attribute names")
+
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = fmt.Sprintf("%q", name)
+	}
+
+	result := make(map[string]bool)
+	queryInput := commercetools.QueryInput{
+		Where: fmt.Sprintf("attributes(name in (%s))", strings.Join(quoted, ", ")),
+		Sort:  []string{"id asc"},
+		Limit: 500,
+	}
+
+	for {
+		response, err := client.ProductTypeQuery(context.Background(), &queryInput)
+		if err != nil {
+			return nil, err
+		}
+		for _, productType := range response.Results {
+			for _, attribute := range productType.Attributes {
+				result[attribute.Name] = true
+			}
+		}
+
+		if response.Count < queryInput.Limit {
+			break
+		}
+		queryInput.Offset += response.Count
+	}
+	return result, nil
+}
+
+func resourceAttributeGroupCreate(d *schema.ResourceData, m interface{}) error {
+	client := getRestClient(m)
+	var group *attributeGroup
+
+	name := commercetools.LocalizedString(
+		expandStringMap(d.Get("name").(map[string]interface{})))
+
+	draft := &attributeGroupDraft{
+		Key:        d.Get("key").(string),
+		Name:       &name,
+		Attributes: expandAttributeGroupAttributes(d.Get("attributes").([]interface{})),
+	}
+
+	if val, ok := d.GetOk("description"); ok {
+		description := commercetools.LocalizedString(
+			expandStringMap(val.(map[string]interface{})))
+		draft.Description = &description
+	}
+
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		group = &attributeGroup{}
+		err := client.create(context.Background(), "attribute-groups", draft, group)
+		if err != nil {
+			return handleCommercetoolsError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(group.ID)
+	d.Set("version", group.Version)
+
+	return resourceAttributeGroupRead(d, m)
+}
+
+func resourceAttributeGroupRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Reading attribute group from commercetools, with attribute group id: %s", d.Id())
+	client := getRestClient(m)
+
+	group := &attributeGroup{}
+	err := client.get(context.Background(), fmt.Sprintf("attribute-groups/%s", d.Id()), nil, group)
+
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			if ctErr.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	log.Print("[DEBUG] Found following attribute group:")
+	log.Print(stringFormatObject(group))
+
+	d.Set("version", group.Version)
+	d.Set("key", group.Key)
+	if group.Name != nil {
+		d.Set("name", *group.Name)
+	}
+	if group.Description != nil {
+		d.Set("description", *group.Description)
+	} else {
+		d.Set("description", nil)
+	}
+
+	attributes := make([]string, len(group.Attributes))
+	for i, attribute := range group.Attributes {
+		attributes[i] = attribute.Key
+	}
+	d.Set("attributes", attributes)
+
+	return nil
+}
+
+func resourceAttributeGroupUpdate(d *schema.ResourceData, m interface{}) error {
+	client := getRestClient(m)
+
+	actions := []interface{}{}
+
+	if d.HasChange("key") {
+		actions = append(actions, attributeGroupSetKeyAction{
+			Action: "setKey",
+			Key:    d.Get("key").(string),
+		})
+	}
+
+	if d.HasChange("name") {
+		newName := commercetools.LocalizedString(
+			expandStringMap(d.Get("name").(map[string]interface{})))
+		actions = append(actions, attributeGroupChangeNameAction{
+			Action: "changeName",
+			Name:   &newName,
+		})
+	}
+
+	if d.HasChange("description") {
+		action := attributeGroupSetDescriptionAction{Action: "setDescription"}
+		if val, ok := d.GetOk("description"); ok {
+			description := commercetools.LocalizedString(
+				expandStringMap(val.(map[string]interface{})))
+			action.Description = &description
+		}
+		actions = append(actions, action)
+	}
+
+	if d.HasChange("attributes") {
+		actions = append(actions, attributeGroupSetAttributesAction{
+			Action:     "setAttributes",
+			Attributes: expandAttributeGroupAttributes(d.Get("attributes").([]interface{})),
+		})
+	}
+
+	log.Printf(
+		"[DEBUG] Will perform update operation with the following actions:\n%s",
+		stringFormatActions(actions))
+
+	err := client.update(
+		context.Background(), fmt.Sprintf("attribute-groups/%s", d.Id()),
+		d.Get("version").(int), actions, nil)
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
+		}
+		return err
+	}
+
+	return resourceAttributeGroupRead(d, m)
+}
+
+func resourceAttributeGroupDelete(d *schema.ResourceData, m interface{}) error {
+	client := getRestClient(m)
+	version := d.Get("version").(int)
+	err := client.delete(context.Background(), fmt.Sprintf("attribute-groups/%s", d.Id()), version, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func expandAttributeGroupAttributes(input []interface{}) []attributeReference {
+	attributes := make([]attributeReference, len(input))
+	for i, name := range expandStringArray(input) {
+		attributes[i] = attributeReference{Key: name}
+	}
+	return attributes
+}
+
+type attributeReference struct {
+	Key string `json:"key"`
+}
+
+type attributeGroup struct {
+	ID          string                         `json:"id"`
+	Version     int                            `json:"version"`
+	Key         string                         `json:"key,omitempty"`
+	Name        *commercetools.LocalizedString `json:"name"`
+	Description *commercetools.LocalizedString `json:"description,omitempty"`
+	Attributes  []attributeReference           `json:"attributes"`
+}
+
+type attributeGroupDraft struct {
+	Key         string                         `json:"key,omitempty"`
+	Name        *commercetools.LocalizedString `json:"name"`
+	Description *commercetools.LocalizedString `json:"description,omitempty"`
+	Attributes  []attributeReference           `json:"attributes"`
+}
+
+type attributeGroupSetKeyAction struct {
+	Action string `json:"action"`
+	Key    string `json:"key,omitempty"`
+}
+
+type attributeGroupChangeNameAction struct {
+	Action string                         `json:"action"`
+	Name   *commercetools.LocalizedString `json:"name"`
+}
+
+type attributeGroupSetDescriptionAction struct {
+	Action      string                         `json:"action"`
+	Description *commercetools.LocalizedString `json:"description,omitempty"`
+}
+
+type attributeGroupSetAttributesAction struct {
+	Action     string               `json:"action"`
+	Attributes []attributeReference `json:"attributes"`
+}
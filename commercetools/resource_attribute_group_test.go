@@ -0,0 +1,138 @@
+package commercetools
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryProductTypeAttributeNames(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/project/product-types", r.URL.Path)
+		assert.Equal(t, `attributes(name in ("width", "depth"))`, r.URL.Query().Get("where"))
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{
+			"limit": 500,
+			"count": 1,
+			"results": [
+				{
+					"id": "furniture",
+					"version": 1,
+					"name": "Furniture",
+					"attributes": [{"name": "width"}, {"name": "height"}]
+				}
+			]
+		}`)
+	}))
+	defer server.Close()
+
+	client := commercetools.New(&commercetools.Config{
+		ProjectKey: "project",
+		URL:        server.URL,
+		HTTPClient: server.Client(),
+	})
+
+	result, err := queryProductTypeAttributeNames(client, []string{"width", "depth"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]bool{"width": true, "height": true}, result)
+}
+
+func TestAccAttributeGroup_createAndUpdate(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckAttributeGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				// The product type is created first, since the attribute
+				// names are validated against the existing product types
+				Config: testAccAttributeGroupProductTypeConfig(),
+			},
+			{
+				Config: testAccAttributeGroupConfig("Dimensions", `["width", "height"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_attribute_group.dimensions", "name.en", "Dimensions",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_attribute_group.dimensions", "attributes.#", "2",
+					),
+				),
+			},
+			{
+				Config: testAccAttributeGroupConfig("Size", `["width"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_attribute_group.dimensions", "name.en", "Size",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_attribute_group.dimensions", "attributes.#", "1",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_attribute_group.dimensions", "attributes.0", "width",
+					),
+				),
+			},
+			{
+				Config:      testAccAttributeGroupConfig("Size", `["width", "depth"]`),
+				ExpectError: regexp.MustCompile("not defined on any product type: depth"),
+			},
+		},
+	})
+}
+
+func testAccAttributeGroupProductTypeConfig() string {
+	return `
+	resource "commercetools_product_type" "furniture" {
+		key  = "furniture"
+		name = "Furniture"
+
+		attribute {
+			name = "width"
+			label = {
+				en = "Width"
+			}
+			type {
+				name = "number"
+			}
+		}
+
+		attribute {
+			name = "height"
+			label = {
+				en = "Height"
+			}
+			type {
+				name = "number"
+			}
+		}
+	}
+	`
+}
+
+func testAccAttributeGroupConfig(name string, attributes string) string {
+	return testAccAttributeGroupProductTypeConfig() + fmt.Sprintf(`
+	resource "commercetools_attribute_group" "dimensions" {
+		key = "dimensions"
+		name = {
+			en = "%s"
+		}
+		description = {
+			en = "Physical dimensions"
+		}
+		attributes = %s
+	}
+	`, name, attributes)
+}
+
+func testAccCheckAttributeGroupDestroy(s *terraform.State) error {
+	return nil
+}
@@ -0,0 +1,578 @@
+package commercetools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+// Business units are not available in the commercetools-go-sdk, so this
+// resource uses the rest client with the types defined at the bottom of this
+// file.
+func resourceBusinessUnit() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceBusinessUnitCreate,
+		Read:   resourceBusinessUnitRead,
+		Update: resourceBusinessUnitUpdate,
+		Delete: resourceBusinessUnitDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceBusinessUnitCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"unit_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Company",
+					"Division",
+				}, false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"contact_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"parent_unit_key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"store_mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Explicit",
+					"FromParent",
+				}, false),
+			},
+			"stores": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"address": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: businessUnitAddressSchema(),
+				},
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func businessUnitAddressSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"key": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"country": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"title": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"salutation": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"first_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"last_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"company": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"department": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"street_name": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"street_number": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"additional_street_info": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"building": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"apartment": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"po_box": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"postal_code": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"city": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"region": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"state": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"phone": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"mobile": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"email": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"additional_address_info": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+	}
+}
+
+// resourceBusinessUnitCustomizeDiff checks the combination of unit type,
+// parent unit and store mode, so mistakes show up in the plan instead of
+// failing halfway through an apply.
+func resourceBusinessUnitCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	unitType := d.Get("unit_type").(string)
+	parentUnitKey := d.Get("parent_unit_key").(string)
+	storeMode := d.Get("store_mode").(string)
+
+	switch unitType {
+	case "Company":
+		if parentUnitKey != "" {
+			return fmt.Errorf("parent_unit_key can not be set for a business unit of type Company")
+		}
+		if storeMode == "FromParent" {
+			return fmt.Errorf("store_mode FromParent is not allowed for a business unit of type Company")
+		}
+	case "Division":
+		if parentUnitKey == "" {
+			return fmt.Errorf("parent_unit_key is required for a business unit of type Division")
+		}
+	}
+
+	if storeMode == "FromParent" && d.Get("stores").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("stores can only be set when store_mode is Explicit")
+	}
+	return nil
+}
+
+func resourceBusinessUnitCreate(d *schema.ResourceData, m interface{}) error {
+	client := getRestClient(m)
+	var unit *businessUnit
+
+	draft := &businessUnitDraft{
+		Key:          d.Get("key").(string),
+		UnitType:     d.Get("unit_type").(string),
+		Name:         d.Get("name").(string),
+		ContactEmail: d.Get("contact_email").(string),
+		StoreMode:    d.Get("store_mode").(string),
+		Stores:       expandBusinessUnitStores(d.Get("stores").(*schema.Set)),
+		Addresses:    expandBusinessUnitAddresses(d.Get("address").([]interface{})),
+	}
+
+	if parentUnitKey := d.Get("parent_unit_key").(string); parentUnitKey != "" {
+		draft.ParentUnit = &businessUnitResourceIdentifier{Key: parentUnitKey}
+	}
+
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		unit = &businessUnit{}
+		err := client.create(context.Background(), "business-units", draft, unit)
+		if err != nil {
+			return handleCommercetoolsError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(unit.ID)
+	d.Set("version", unit.Version)
+
+	return resourceBusinessUnitRead(d, m)
+}
+
+func resourceBusinessUnitRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Reading business unit from commercetools, with business unit id: %s", d.Id())
+	client := getRestClient(m)
+
+	unit := &businessUnit{}
+	err := client.get(context.Background(), fmt.Sprintf("business-units/%s", d.Id()), nil, unit)
+
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			if ctErr.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	log.Print("[DEBUG] Found following business unit:")
+	log.Print(stringFormatObject(unit))
+
+	d.Set("version", unit.Version)
+	d.Set("key", unit.Key)
+	d.Set("unit_type", unit.UnitType)
+	d.Set("name", unit.Name)
+	d.Set("contact_email", unit.ContactEmail)
+	d.Set("store_mode", unit.StoreMode)
+	if unit.ParentUnit != nil {
+		d.Set("parent_unit_key", unit.ParentUnit.Key)
+	} else {
+		d.Set("parent_unit_key", "")
+	}
+
+	stores := make([]string, len(unit.Stores))
+	for i, store := range unit.Stores {
+		stores[i] = store.Key
+	}
+	d.Set("stores", stores)
+
+	addresses := make([]map[string]interface{}, len(unit.Addresses))
+	for i, address := range unit.Addresses {
+		addresses[i] = flattenBusinessUnitAddress(address)
+	}
+	d.Set("address", addresses)
+
+	return nil
+}
+
+func resourceBusinessUnitUpdate(d *schema.ResourceData, m interface{}) error {
+	client := getRestClient(m)
+
+	actions := []interface{}{}
+
+	if d.HasChange("name") {
+		actions = append(actions, businessUnitChangeNameAction{
+			Action: "changeName",
+			Name:   d.Get("name").(string),
+		})
+	}
+
+	if d.HasChange("contact_email") {
+		actions = append(actions, businessUnitSetContactEmailAction{
+			Action:       "setContactEmail",
+			ContactEmail: d.Get("contact_email").(string),
+		})
+	}
+
+	if d.HasChange("parent_unit_key") {
+		actions = append(actions, businessUnitChangeParentUnitAction{
+			Action:     "changeParentUnit",
+			ParentUnit: businessUnitResourceIdentifier{Key: d.Get("parent_unit_key").(string)},
+		})
+	}
+
+	// Changing the store mode replaces the stores as well, so the stores are
+	// only updated separately when the store mode is left untouched.
+	if d.HasChange("store_mode") {
+		actions = append(actions, businessUnitSetStoreModeAction{
+			Action:    "setStoreMode",
+			StoreMode: d.Get("store_mode").(string),
+			Stores:    expandBusinessUnitStores(d.Get("stores").(*schema.Set)),
+		})
+	} else if d.HasChange("stores") {
+		old, new := d.GetChange("stores")
+		oldStores := old.(*schema.Set)
+		newStores := new.(*schema.Set)
+
+		for _, store := range expandBusinessUnitStores(oldStores.Difference(newStores)) {
+			actions = append(actions, businessUnitStoreAction{
+				Action: "removeStore",
+				Store:  store,
+			})
+		}
+		for _, store := range expandBusinessUnitStores(newStores.Difference(oldStores)) {
+			actions = append(actions, businessUnitStoreAction{
+				Action: "addStore",
+				Store:  store,
+			})
+		}
+	}
+
+	if d.HasChange("address") {
+		old, new := d.GetChange("address")
+		actions = append(
+			actions,
+			resourceBusinessUnitAddressActions(old.([]interface{}), new.([]interface{}))...)
+	}
+
+	log.Printf(
+		"[DEBUG] Will perform update operation with the following actions:\n%s",
+		stringFormatActions(actions))
+
+	err := client.update(
+		context.Background(), fmt.Sprintf("business-units/%s", d.Id()),
+		d.Get("version").(int), actions, nil)
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
+		}
+		return err
+	}
+
+	return resourceBusinessUnitRead(d, m)
+}
+
+func resourceBusinessUnitDelete(d *schema.ResourceData, m interface{}) error {
+	client := getRestClient(m)
+	version := d.Get("version").(int)
+	err := client.delete(context.Background(), fmt.Sprintf("business-units/%s", d.Id()), version, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// resourceBusinessUnitAddressActions matches the old and new addresses on
+// their key and returns the actions to remove, change and add addresses.
+func resourceBusinessUnitAddressActions(old []interface{}, new []interface{}) []interface{} {
+	oldAddresses := expandBusinessUnitAddresses(old)
+	newAddresses := expandBusinessUnitAddresses(new)
+
+	oldLookup := make(map[string]commercetools.Address)
+	for _, address := range oldAddresses {
+		oldLookup[address.Key] = address
+	}
+	newLookup := make(map[string]commercetools.Address)
+	for _, address := range newAddresses {
+		newLookup[address.Key] = address
+	}
+
+	actions := []interface{}{}
+	for _, address := range oldAddresses {
+		if _, ok := newLookup[address.Key]; !ok {
+			actions = append(actions, businessUnitRemoveAddressAction{
+				Action:     "removeAddress",
+				AddressKey: address.Key,
+			})
+		}
+	}
+	for _, address := range newAddresses {
+		oldAddress, ok := oldLookup[address.Key]
+		if !ok {
+			actions = append(actions, businessUnitAddAddressAction{
+				Action:  "addAddress",
+				Address: address,
+			})
+			continue
+		}
+		if !reflect.DeepEqual(oldAddress, address) {
+			actions = append(actions, businessUnitChangeAddressAction{
+				Action:     "changeAddress",
+				AddressKey: address.Key,
+				Address:    address,
+			})
+		}
+	}
+	return actions
+}
+
+func expandBusinessUnitStores(input *schema.Set) []commercetools.StoreResourceIdentifier {
+	keys := expandStringArray(input.List())
+	sort.Strings(keys)
+
+	stores := make([]commercetools.StoreResourceIdentifier, len(keys))
+	for i, key := range keys {
+		stores[i] = commercetools.StoreResourceIdentifier{Key: key}
+	}
+	return stores
+}
+
+func expandBusinessUnitAddresses(input []interface{}) []commercetools.Address {
+	addresses := make([]commercetools.Address, len(input))
+	for i, raw := range input {
+		address := raw.(map[string]interface{})
+		addresses[i] = commercetools.Address{
+			Key:                   address["key"].(string),
+			Country:               commercetools.CountryCode(address["country"].(string)),
+			Title:                 address["title"].(string),
+			Salutation:            address["salutation"].(string),
+			FirstName:             address["first_name"].(string),
+			LastName:              address["last_name"].(string),
+			Company:               address["company"].(string),
+			Department:            address["department"].(string),
+			StreetName:            address["street_name"].(string),
+			StreetNumber:          address["street_number"].(string),
+			AdditionalStreetInfo:  address["additional_street_info"].(string),
+			Building:              address["building"].(string),
+			Apartment:             address["apartment"].(string),
+			POBox:                 address["po_box"].(string),
+			PostalCode:            address["postal_code"].(string),
+			City:                  address["city"].(string),
+			Region:                address["region"].(string),
+			State:                 address["state"].(string),
+			Phone:                 address["phone"].(string),
+			Mobile:                address["mobile"].(string),
+			Email:                 address["email"].(string),
+			AdditionalAddressInfo: address["additional_address_info"].(string),
+		}
+	}
+	return addresses
+}
+
+func flattenBusinessUnitAddress(address commercetools.Address) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                      address.ID,
+		"key":                     address.Key,
+		"country":                 string(address.Country),
+		"title":                   address.Title,
+		"salutation":              address.Salutation,
+		"first_name":              address.FirstName,
+		"last_name":               address.LastName,
+		"company":                 address.Company,
+		"department":              address.Department,
+		"street_name":             address.StreetName,
+		"street_number":           address.StreetNumber,
+		"additional_street_info":  address.AdditionalStreetInfo,
+		"building":                address.Building,
+		"apartment":               address.Apartment,
+		"po_box":                  address.POBox,
+		"postal_code":             address.PostalCode,
+		"city":                    address.City,
+		"region":                  address.Region,
+		"state":                   address.State,
+		"phone":                   address.Phone,
+		"mobile":                  address.Mobile,
+		"email":                   address.Email,
+		"additional_address_info": address.AdditionalAddressInfo,
+	}
+}
+
+type businessUnitResourceIdentifier struct {
+	Key string `json:"key"`
+}
+
+// MarshalJSON override to set the type id
+func (obj businessUnitResourceIdentifier) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{
+		"typeId": "business-unit",
+		"key":    obj.Key,
+	})
+}
+
+type businessUnitKeyReference struct {
+	Key string `json:"key"`
+}
+
+type businessUnit struct {
+	ID           string                            `json:"id"`
+	Version      int                               `json:"version"`
+	Key          string                            `json:"key"`
+	UnitType     string                            `json:"unitType"`
+	Name         string                            `json:"name"`
+	ContactEmail string                            `json:"contactEmail,omitempty"`
+	StoreMode    string                            `json:"storeMode"`
+	Stores       []commercetools.StoreKeyReference `json:"stores,omitempty"`
+	ParentUnit   *businessUnitKeyReference         `json:"parentUnit,omitempty"`
+	Addresses    []commercetools.Address           `json:"addresses,omitempty"`
+}
+
+type businessUnitDraft struct {
+	Key          string                                  `json:"key"`
+	UnitType     string                                  `json:"unitType"`
+	Name         string                                  `json:"name"`
+	ContactEmail string                                  `json:"contactEmail,omitempty"`
+	StoreMode    string                                  `json:"storeMode,omitempty"`
+	Stores       []commercetools.StoreResourceIdentifier `json:"stores,omitempty"`
+	ParentUnit   *businessUnitResourceIdentifier         `json:"parentUnit,omitempty"`
+	Addresses    []commercetools.Address                 `json:"addresses,omitempty"`
+}
+
+type businessUnitChangeNameAction struct {
+	Action string `json:"action"`
+	Name   string `json:"name"`
+}
+
+type businessUnitSetContactEmailAction struct {
+	Action       string `json:"action"`
+	ContactEmail string `json:"contactEmail,omitempty"`
+}
+
+type businessUnitChangeParentUnitAction struct {
+	Action     string                         `json:"action"`
+	ParentUnit businessUnitResourceIdentifier `json:"parentUnit"`
+}
+
+type businessUnitSetStoreModeAction struct {
+	Action    string                                  `json:"action"`
+	StoreMode string                                  `json:"storeMode"`
+	Stores    []commercetools.StoreResourceIdentifier `json:"stores,omitempty"`
+}
+
+type businessUnitStoreAction struct {
+	Action string                                `json:"action"`
+	Store  commercetools.StoreResourceIdentifier `json:"store"`
+}
+
+type businessUnitAddAddressAction struct {
+	Action  string                `json:"action"`
+	Address commercetools.Address `json:"address"`
+}
+
+type businessUnitChangeAddressAction struct {
+	Action     string                `json:"action"`
+	AddressKey string                `json:"addressKey"`
+	Address    commercetools.Address `json:"address"`
+}
+
+type businessUnitRemoveAddressAction struct {
+	Action     string `json:"action"`
+	AddressKey string `json:"addressKey"`
+}
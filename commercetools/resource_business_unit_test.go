@@ -0,0 +1,152 @@
+package commercetools
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResourceBusinessUnitAddressActions(t *testing.T) {
+	address := func(key string, city string) map[string]interface{} {
+		return flattenBusinessUnitAddress(commercetools.Address{
+			Key:     key,
+			Country: "NL",
+			City:    city,
+		})
+	}
+
+	old := []interface{}{
+		address("office", "Amsterdam"),
+		address("warehouse", "Utrecht"),
+	}
+	new := []interface{}{
+		address("office", "Rotterdam"),
+		address("store", "Utrecht"),
+	}
+
+	actions := resourceBusinessUnitAddressActions(old, new)
+	assert.Equal(t, []interface{}{
+		businessUnitRemoveAddressAction{
+			Action:     "removeAddress",
+			AddressKey: "warehouse",
+		},
+		businessUnitChangeAddressAction{
+			Action:     "changeAddress",
+			AddressKey: "office",
+			Address: commercetools.Address{
+				Key:     "office",
+				Country: "NL",
+				City:    "Rotterdam",
+			},
+		},
+		businessUnitAddAddressAction{
+			Action: "addAddress",
+			Address: commercetools.Address{
+				Key:     "store",
+				Country: "NL",
+				City:    "Utrecht",
+			},
+		},
+	}, actions)
+
+	assert.Empty(t, resourceBusinessUnitAddressActions(new, new))
+}
+
+func TestAccBusinessUnit_companyAndDivision(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckBusinessUnitDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBusinessUnitConfig("Acme", "Amsterdam", `commercetools_store.north.key`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_business_unit.company", "unit_type", "Company",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_business_unit.company", "store_mode", "Explicit",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_business_unit.company", "stores.#", "1",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_business_unit.company", "address.0.city", "Amsterdam",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_business_unit.division", "parent_unit_key", "acme",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_business_unit.division", "store_mode", "FromParent",
+					),
+				),
+			},
+			{
+				Config: testAccBusinessUnitConfig(
+					"Acme Inc.", "Rotterdam", `commercetools_store.north.key, commercetools_store.south.key`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_business_unit.company", "name", "Acme Inc.",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_business_unit.company", "stores.#", "2",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_business_unit.company", "address.0.city", "Rotterdam",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccBusinessUnitConfig(name string, city string, stores string) string {
+	return fmt.Sprintf(`
+	resource "commercetools_store" "north" {
+		key = "bu-north"
+		name = {
+			en = "North"
+		}
+	}
+
+	resource "commercetools_store" "south" {
+		key = "bu-south"
+		name = {
+			en = "South"
+		}
+	}
+
+	resource "commercetools_business_unit" "company" {
+		key           = "acme"
+		unit_type     = "Company"
+		name          = "%s"
+		contact_email = "info@example.com"
+		store_mode    = "Explicit"
+		stores        = [%s]
+
+		address {
+			key         = "office"
+			country     = "NL"
+			street_name = "Main street"
+			city        = "%s"
+		}
+	}
+
+	resource "commercetools_business_unit" "division" {
+		key             = "acme-sales"
+		unit_type       = "Division"
+		name            = "Sales"
+		parent_unit_key = commercetools_business_unit.company.key
+		store_mode      = "FromParent"
+	}
+	`, name, stores, city)
+}
+
+func testAccCheckBusinessUnitDestroy(s *terraform.State) error {
+	return nil
+}
@@ -2,9 +2,12 @@ package commercetools
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -50,7 +53,7 @@ func resourceCartDiscount() *schema.Resource {
 							Type:     schema.TypeInt,
 							Optional: true,
 						},
-						// Absolute discount specific fields
+						// Absolute and fixed discount specific fields
 						"money": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -107,6 +110,24 @@ func resourceCartDiscount() *schema.Resource {
 							Type:     schema.TypeString,
 							Optional: true,
 						},
+						// MultiBuyLineItems/MultiBuyCustomLineItems target
+						// specific fields
+						"trigger_quantity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"discounted_quantity": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"max_occurrence": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						"selection_mode": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
 					},
 				},
 			},
@@ -138,6 +159,11 @@ func resourceCartDiscount() *schema.Resource {
 				ValidateFunc: validateStackingMode,
 				Default:      "Stacking",
 			},
+			"stores": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
 			"version": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -151,6 +177,7 @@ func validateValueType(val interface{}, key string) (warns []string, errs []erro
 	case
 		"relative",
 		"absolute",
+		"fixed",
 		"giftLineItem":
 		return
 	default:
@@ -164,6 +191,8 @@ func validateTargetType(val interface{}, key string) (warns []string, errs []err
 	case
 		"lineItems",
 		"customLineItems",
+		"multiBuyLineItems",
+		"multiBuyCustomLineItems",
 		"shipping":
 		return
 	default:
@@ -185,7 +214,7 @@ func validateStackingMode(val interface{}, key string) (warns []string, errs []e
 }
 
 func resourceCartDiscountCreate(d *schema.ResourceData, m interface{}) error {
-	client := getClient(m)
+	client := getRestClient(m)
 	var cartDiscount *commercetools.CartDiscount
 
 	name := commercetools.LocalizedString(
@@ -238,10 +267,16 @@ func resourceCartDiscountCreate(d *schema.ResourceData, m interface{}) error {
 		draft.ValidUntil = &validUntil
 	}
 
-	errorResponse := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
+	// The stores are not part of the CartDiscountDraft of the sdk, so the
+	// draft is extended with them and send with the rest client.
+	input := &cartDiscountDraft{
+		CartDiscountDraft: draft,
+		Stores:            resourceCartDiscountGetStores(d),
+	}
 
-		cartDiscount, err = client.CartDiscountCreate(context.Background(), draft)
+	errorResponse := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		cartDiscount = &commercetools.CartDiscount{}
+		err := client.create(context.Background(), "cart-discounts", input, cartDiscount)
 
 		if err != nil {
 			return handleCommercetoolsError(err)
@@ -266,9 +301,12 @@ func resourceCartDiscountCreate(d *schema.ResourceData, m interface{}) error {
 func resourceCartDiscountRead(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] Reading cart discount from commercetools, with cartDiscount id: %s", d.Id())
 
-	client := getClient(m)
+	client := getRestClient(m)
 
-	cartDiscount, err := client.CartDiscountGetWithID(context.Background(), d.Id())
+	// The cart discount is read as raw json since the sdk doesn't support the
+	// fixed value type and the stores of a cart discount.
+	var data json.RawMessage
+	err := client.get(context.Background(), fmt.Sprintf("cart-discounts/%s", d.Id()), nil, &data)
 
 	if err != nil {
 		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
@@ -280,28 +318,45 @@ func resourceCartDiscountRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
-	if cartDiscount == nil {
-		log.Print("[DEBUG] No cart discount found")
-		d.SetId("")
-	} else {
-		log.Print("[DEBUG] Found following cart discount:")
-		log.Print(stringFormatObject(cartDiscount))
-
-		d.Set("version", cartDiscount.Version)
-		d.Set("key", cartDiscount.Key)
-		d.Set("name", cartDiscount.Name)
-		d.Set("description", cartDiscount.Description)
-		d.Set("value", cartDiscount.Value)
-		d.Set("predicate", cartDiscount.CartPredicate)
-		d.Set("target", cartDiscount.Target)
-		d.Set("sort_order", cartDiscount.SortOrder)
-		d.Set("is_active", cartDiscount.IsActive)
-		d.Set("valid_from", cartDiscount.ValidFrom)
-		d.Set("valid_until", cartDiscount.ValidUntil)
-		d.Set("requires_discount_code", cartDiscount.RequiresDiscountCode)
-		d.Set("stacking_mode", cartDiscount.StackingMode)
+	cartDiscount := commercetools.CartDiscount{}
+	if err := json.Unmarshal(data, &cartDiscount); err != nil {
+		return err
+	}
+	extraFields := cartDiscountExtraFields{}
+	if err := json.Unmarshal(data, &extraFields); err != nil {
+		return err
 	}
 
+	log.Print("[DEBUG] Found following cart discount:")
+	log.Print(stringFormatObject(cartDiscount))
+
+	value, err := flattenCartDiscountValue(extraFields.Value)
+	if err != nil {
+		return err
+	}
+
+	stores := make([]string, 0, len(extraFields.Stores))
+	for _, store := range extraFields.Stores {
+		stores = append(stores, store.Key)
+	}
+
+	d.Set("version", cartDiscount.Version)
+	d.Set("key", cartDiscount.Key)
+	d.Set("name", cartDiscount.Name)
+	d.Set("description", cartDiscount.Description)
+	if err := d.Set("value", value); err != nil {
+		return err
+	}
+	d.Set("predicate", cartDiscount.CartPredicate)
+	d.Set("target", flattenCartDiscountTarget(cartDiscount.Target))
+	d.Set("sort_order", cartDiscount.SortOrder)
+	d.Set("is_active", cartDiscount.IsActive)
+	d.Set("valid_from", cartDiscount.ValidFrom)
+	d.Set("valid_until", cartDiscount.ValidUntil)
+	d.Set("requires_discount_code", cartDiscount.RequiresDiscountCode)
+	d.Set("stacking_mode", cartDiscount.StackingMode)
+	d.Set("stores", stores)
+
 	return nil
 }
 
@@ -436,6 +491,12 @@ func resourceCartDiscountUpdate(d *schema.ResourceData, m interface{}) error {
 			&commercetools.CartDiscountChangeStackingModeAction{StackingMode: newStackingMode})
 	}
 
+	if d.HasChange("stores") {
+		input.Actions = append(
+			input.Actions,
+			&cartDiscountSetStoresAction{Stores: resourceCartDiscountGetStores(d)})
+	}
+
 	log.Printf(
 		"[DEBUG] Will perform update operation with the following actions:\n%s",
 		stringFormatActions(input.Actions))
@@ -473,6 +534,11 @@ func resourceCartDiscountGetValue(d *schema.ResourceData) (commercetools.CartDis
 		return commercetools.CartDiscountValueAbsoluteDraft{
 			Money: money,
 		}, nil
+	case "fixed":
+		money := resourceCartDiscountGetMoney(value)
+		return cartDiscountValueFixedDraft{
+			Money: money,
+		}, nil
 	case "giftLineItem":
 
 		draft := &commercetools.CartDiscountValueGiftLineItemDraft{}
@@ -525,6 +591,8 @@ func resourceCartDiscountGetTarget(d *schema.ResourceData) (commercetools.CartDi
 		return commercetools.CartDiscountCustomLineItemsTarget{
 			Predicate: input["predicate"].(string),
 		}, nil
+	case "multiBuyLineItems", "multiBuyCustomLineItems":
+		return resourceCartDiscountGetMultiBuyTarget(input)
 	case "shipping":
 		return commercetools.CartDiscountShippingCostTarget{}, nil
 	default:
@@ -533,6 +601,222 @@ func resourceCartDiscountGetTarget(d *schema.ResourceData) (commercetools.CartDi
 
 }
 
+// resourceCartDiscountGetMultiBuyTarget parses the multi buy fields of the
+// target. The target is a map, so all values are strings.
+func resourceCartDiscountGetMultiBuyTarget(input map[string]interface{}) (commercetools.CartDiscountTarget, error) {
+	predicate, _ := input["predicate"].(string)
+	quantities := map[string]int{}
+	for _, field := range []string{"trigger_quantity", "discounted_quantity", "max_occurrence"} {
+		raw, ok := input[field].(string)
+		if !ok || raw == "" {
+			continue
+		}
+		value, err := strconv.Atoi(raw)
+		if err != nil {
+			return nil, fmt.Errorf("Target field %s should be a number, got %q", field, raw)
+		}
+		quantities[field] = value
+	}
+
+	for _, field := range []string{"trigger_quantity", "discounted_quantity"} {
+		if _, ok := quantities[field]; !ok {
+			return nil, fmt.Errorf("Target field %s is required for target type %s", field, input["type"])
+		}
+	}
+
+	var selectionMode commercetools.SelectionMode
+	switch input["selection_mode"] {
+	case "", nil:
+		return nil, fmt.Errorf("Target field selection_mode is required for target type %s", input["type"])
+	case "Cheapest":
+		selectionMode = commercetools.SelectionModeCheapest
+	case "MostExpensive":
+		selectionMode = commercetools.SelectionModeMostExpensive
+	default:
+		return nil, fmt.Errorf("Selection mode %s not implemented", input["selection_mode"])
+	}
+
+	if input["type"] == "multiBuyCustomLineItems" {
+		return commercetools.MultiBuyCustomLineItemsTarget{
+			Predicate:          predicate,
+			TriggerQuantity:    quantities["trigger_quantity"],
+			DiscountedQuantity: quantities["discounted_quantity"],
+			MaxOccurrence:      quantities["max_occurrence"],
+			SelectionMode:      selectionMode,
+		}, nil
+	}
+	return commercetools.MultiBuyLineItemsTarget{
+		Predicate:          predicate,
+		TriggerQuantity:    quantities["trigger_quantity"],
+		DiscountedQuantity: quantities["discounted_quantity"],
+		MaxOccurrence:      quantities["max_occurrence"],
+		SelectionMode:      selectionMode,
+	}, nil
+}
+
+func resourceCartDiscountGetStores(d *schema.ResourceData) []commercetools.StoreResourceIdentifier {
+	keys := expandStringArray(d.Get("stores").(*schema.Set).List())
+	sort.Strings(keys)
+
+	stores := make([]commercetools.StoreResourceIdentifier, 0, len(keys))
+	for _, key := range keys {
+		stores = append(stores, commercetools.StoreResourceIdentifier{Key: key})
+	}
+	return stores
+}
+
+func flattenCartDiscountValue(data json.RawMessage) ([]map[string]interface{}, error) {
+	if len(data) == 0 {
+		return []map[string]interface{}{}, nil
+	}
+
+	discriminator := struct {
+		Type string `json:"type"`
+	}{}
+	if err := json.Unmarshal(data, &discriminator); err != nil {
+		return nil, err
+	}
+
+	switch discriminator.Type {
+	case "relative":
+		value := commercetools.CartDiscountValueRelative{}
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil, err
+		}
+		return []map[string]interface{}{
+			{
+				"type":      "relative",
+				"permyriad": value.Permyriad,
+			},
+		}, nil
+	case "absolute", "fixed":
+		// A fixed value has the same fields as an absolute value
+		value := commercetools.CartDiscountValueAbsolute{}
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil, err
+		}
+		money := make([]map[string]interface{}, 0, len(value.Money))
+		for _, item := range value.Money {
+			if flattened := flattenTypedMoney(item); flattened != nil {
+				money = append(money, flattened)
+			}
+		}
+		return []map[string]interface{}{
+			{
+				"type":  discriminator.Type,
+				"money": money,
+			},
+		}, nil
+	case "giftLineItem":
+		value := commercetools.CartDiscountValueGiftLineItem{}
+		if err := json.Unmarshal(data, &value); err != nil {
+			return nil, err
+		}
+		result := map[string]interface{}{
+			"type":    "giftLineItem",
+			"variant": value.VariantID,
+		}
+		if value.Product != nil {
+			result["product_id"] = value.Product.ID
+		}
+		if value.SupplyChannel != nil {
+			result["supply_channel_id"] = value.SupplyChannel.ID
+		}
+		if value.DistributionChannel != nil {
+			result["distribution_channel_id"] = value.DistributionChannel.ID
+		}
+		return []map[string]interface{}{result}, nil
+	default:
+		return nil, fmt.Errorf("Value type %s not implemented", discriminator.Type)
+	}
+}
+
+func flattenCartDiscountTarget(target commercetools.CartDiscountTarget) map[string]interface{} {
+	switch typedTarget := target.(type) {
+	case commercetools.CartDiscountLineItemsTarget:
+		return map[string]interface{}{
+			"type":      "lineItems",
+			"predicate": typedTarget.Predicate,
+		}
+	case commercetools.CartDiscountCustomLineItemsTarget:
+		return map[string]interface{}{
+			"type":      "customLineItems",
+			"predicate": typedTarget.Predicate,
+		}
+	case commercetools.MultiBuyLineItemsTarget:
+		return flattenCartDiscountMultiBuyTarget(
+			"multiBuyLineItems", typedTarget.Predicate, typedTarget.TriggerQuantity,
+			typedTarget.DiscountedQuantity, typedTarget.MaxOccurrence, typedTarget.SelectionMode)
+	case commercetools.MultiBuyCustomLineItemsTarget:
+		return flattenCartDiscountMultiBuyTarget(
+			"multiBuyCustomLineItems", typedTarget.Predicate, typedTarget.TriggerQuantity,
+			typedTarget.DiscountedQuantity, typedTarget.MaxOccurrence, typedTarget.SelectionMode)
+	case commercetools.CartDiscountShippingCostTarget:
+		return map[string]interface{}{
+			"type": "shipping",
+		}
+	}
+	return nil
+}
+
+func flattenCartDiscountMultiBuyTarget(
+	targetType string, predicate string, triggerQuantity int,
+	discountedQuantity int, maxOccurrence int, selectionMode commercetools.SelectionMode) map[string]interface{} {
+
+	result := map[string]interface{}{
+		"type":                targetType,
+		"predicate":           predicate,
+		"trigger_quantity":    strconv.Itoa(triggerQuantity),
+		"discounted_quantity": strconv.Itoa(discountedQuantity),
+		"selection_mode":      string(selectionMode),
+	}
+	if maxOccurrence > 0 {
+		result["max_occurrence"] = strconv.Itoa(maxOccurrence)
+	}
+	return result
+}
+
+// cartDiscountDraft extends the sdk draft with the fields it doesn't support
+type cartDiscountDraft struct {
+	*commercetools.CartDiscountDraft
+	Stores []commercetools.StoreResourceIdentifier `json:"stores,omitempty"`
+}
+
+// cartDiscountExtraFields contains the cart discount fields which the sdk
+// can't read
+type cartDiscountExtraFields struct {
+	Value  json.RawMessage                   `json:"value"`
+	Stores []commercetools.StoreKeyReference `json:"stores"`
+}
+
+// cartDiscountValueFixedDraft implements the interface CartDiscountValueDraft
+type cartDiscountValueFixedDraft struct {
+	Money []commercetools.Money `json:"money"`
+}
+
+// MarshalJSON override to set the discriminator value
+func (obj cartDiscountValueFixedDraft) MarshalJSON() ([]byte, error) {
+	type Alias cartDiscountValueFixedDraft
+	return json.Marshal(struct {
+		Type string `json:"type"`
+		*Alias
+	}{Type: "fixed", Alias: (*Alias)(&obj)})
+}
+
+// cartDiscountSetStoresAction implements the interface CartDiscountUpdateAction
+type cartDiscountSetStoresAction struct {
+	Stores []commercetools.StoreResourceIdentifier `json:"stores"`
+}
+
+// MarshalJSON override to set the discriminator value
+func (obj cartDiscountSetStoresAction) MarshalJSON() ([]byte, error) {
+	type Alias cartDiscountSetStoresAction
+	return json.Marshal(struct {
+		Action string `json:"action"`
+		*Alias
+	}{Action: "setStores", Alias: (*Alias)(&obj)})
+}
+
 func resourceCartDiscountGetStackingMode(d *schema.ResourceData) (commercetools.StackingMode, error) {
 	switch d.Get("stacking_mode").(string) {
 	case "Stacking":
@@ -1,12 +1,70 @@
 package commercetools
 
 import (
+	"encoding/json"
+	"fmt"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestFlattenCartDiscountValue(t *testing.T) {
+	result, err := flattenCartDiscountValue(json.RawMessage(`{
+		"type": "fixed",
+		"money": [
+			{
+				"type": "centPrecision",
+				"currencyCode": "EUR",
+				"centAmount": 1000,
+				"fractionDigits": 2
+			}
+		]
+	}`))
+	assert.NoError(t, err)
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"type": "fixed",
+			"money": []map[string]interface{}{
+				{
+					"currency_code": "EUR",
+					"cent_amount":   1000,
+				},
+			},
+		},
+	}, result)
+
+	_, err = flattenCartDiscountValue(json.RawMessage(`{"type": "unknown"}`))
+	assert.Error(t, err)
+}
+
+func TestResourceCartDiscountGetMultiBuyTarget(t *testing.T) {
+	_, err := resourceCartDiscountGetMultiBuyTarget(map[string]interface{}{
+		"type":             "multiBuyLineItems",
+		"predicate":        "1=1",
+		"trigger_quantity": "3",
+		"selection_mode":   "Cheapest",
+	})
+	assert.EqualError(t, err, "Target field discounted_quantity is required for target type multiBuyLineItems")
+
+	target, err := resourceCartDiscountGetMultiBuyTarget(map[string]interface{}{
+		"type":                "multiBuyLineItems",
+		"predicate":           "1=1",
+		"trigger_quantity":    "3",
+		"discounted_quantity": "1",
+		"selection_mode":      "MostExpensive",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]interface{}{
+		"type":                "multiBuyLineItems",
+		"predicate":           "1=1",
+		"trigger_quantity":    "3",
+		"discounted_quantity": "1",
+		"selection_mode":      "MostExpensive",
+	}, flattenCartDiscountTarget(target))
+}
+
 func TestAccCartDiscountCreate_basic(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
@@ -159,6 +217,90 @@ func TestAccCartDiscountCreate_basic(t *testing.T) {
 	})
 }
 
+func TestAccCartDiscountCreate_multiBuy(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCartDiscountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCartDiscountMultiBuyConfig("Cheapest", `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_cart_discount.multi_buy", "target.type", "multiBuyLineItems",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_cart_discount.multi_buy", "target.trigger_quantity", "3",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_cart_discount.multi_buy", "target.discounted_quantity", "1",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_cart_discount.multi_buy", "target.selection_mode", "Cheapest",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_cart_discount.multi_buy", "value.0.type", "fixed",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_cart_discount.multi_buy", "value.0.money.0.cent_amount", "500",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_cart_discount.multi_buy", "stores.#", "0",
+					),
+				),
+			},
+			{
+				Config: testAccCartDiscountMultiBuyConfig(
+					"MostExpensive", `[commercetools_store.standard.key]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_cart_discount.multi_buy", "target.selection_mode", "MostExpensive",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_cart_discount.multi_buy", "stores.#", "1",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccCartDiscountMultiBuyConfig(selectionMode string, stores string) string {
+	return fmt.Sprintf(`
+	resource "commercetools_store" "standard" {
+		key = "cart-discount-store"
+		name = {
+			en = "Cart discount store"
+		}
+	}
+
+	resource "commercetools_cart_discount" "multi_buy" {
+		key = "multi-buy"
+		name = {
+			en = "3 for the price of 2"
+		}
+		sort_order = "0.7"
+		predicate  = "1=1"
+		target = {
+			type                = "multiBuyLineItems"
+			predicate           = "1=1"
+			trigger_quantity    = 3
+			discounted_quantity = 1
+			selection_mode      = "%s"
+		}
+		value {
+			type = "fixed"
+			money {
+				currency_code = "EUR"
+				cent_amount   = 500
+			}
+		}
+		stores = %s
+	}
+	`, selectionMode, stores)
+}
+
 func testAccCartDiscountConfig() string {
 	return `
 	resource "commercetools_cart_discount" "standard" {
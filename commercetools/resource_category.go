@@ -0,0 +1,395 @@
+package commercetools
+
+import (
+	"context"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+func resourceCategory() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceCategoryCreate,
+		Read:   resourceCategoryRead,
+		Update: resourceCategoryUpdate,
+		Delete: resourceCategoryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		CustomizeDiff: resourceCategoryCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     TypeLocalizedString,
+				Required: true,
+			},
+			"slug": {
+				Type:     TypeLocalizedString,
+				Required: true,
+			},
+			"description": {
+				Type:     TypeLocalizedString,
+				Optional: true,
+			},
+			"parent_id": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"parent_key"},
+			},
+			"parent_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"parent_id"},
+			},
+			"order_hint": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"external_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"meta_title": {
+				Type:     TypeLocalizedString,
+				Optional: true,
+			},
+			"meta_description": {
+				Type:     TypeLocalizedString,
+				Optional: true,
+			},
+			"meta_keywords": {
+				Type:     TypeLocalizedString,
+				Optional: true,
+			},
+			"custom": customFieldsSchema(),
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceCategoryCustomizeDiff forces a new category when the parent is
+// removed, commercetools has no update action to move a category back to the
+// root level.
+func resourceCategoryCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	oldID, newID := d.GetChange("parent_id")
+	oldKey, newKey := d.GetChange("parent_key")
+
+	hadParent := oldID.(string) != "" || oldKey.(string) != ""
+	hasParent := newID.(string) != "" || newKey.(string) != ""
+	if !hadParent || hasParent {
+		return nil
+	}
+
+	for _, field := range []string{"parent_id", "parent_key"} {
+		if d.HasChange(field) {
+			if err := d.ForceNew(field); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func resourceCategoryCreate(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+	var category *commercetools.Category
+
+	name := commercetools.LocalizedString(
+		expandStringMap(d.Get("name").(map[string]interface{})))
+	slug := commercetools.LocalizedString(
+		expandStringMap(d.Get("slug").(map[string]interface{})))
+	description := commercetools.LocalizedString(
+		expandStringMap(d.Get("description").(map[string]interface{})))
+	metaTitle := commercetools.LocalizedString(
+		expandStringMap(d.Get("meta_title").(map[string]interface{})))
+	metaDescription := commercetools.LocalizedString(
+		expandStringMap(d.Get("meta_description").(map[string]interface{})))
+	metaKeywords := commercetools.LocalizedString(
+		expandStringMap(d.Get("meta_keywords").(map[string]interface{})))
+
+	custom, err := expandCustomFieldsDraft(d)
+	if err != nil {
+		return err
+	}
+
+	draft := &commercetools.CategoryDraft{
+		Key:             d.Get("key").(string),
+		Name:            &name,
+		Slug:            &slug,
+		Description:     &description,
+		Parent:          resourceCategoryGetParent(d),
+		OrderHint:       d.Get("order_hint").(string),
+		ExternalID:      d.Get("external_id").(string),
+		MetaTitle:       &metaTitle,
+		MetaDescription: &metaDescription,
+		MetaKeywords:    &metaKeywords,
+		Custom:          custom,
+	}
+
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+
+		category, err = client.CategoryCreate(context.Background(), draft)
+		if err != nil {
+			return handleCommercetoolsError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(category.ID)
+	d.Set("version", category.Version)
+
+	return resourceCategoryRead(d, m)
+}
+
+func resourceCategoryRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Reading category from commercetools, with category id: %s", d.Id())
+
+	category, err := readCategory(m, d.Id())
+
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			if ctErr.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	log.Print("[DEBUG] Found following category:")
+	log.Print(stringFormatObject(category))
+
+	d.Set("version", category.Version)
+	d.Set("key", category.Key)
+	d.Set("name", *category.Name)
+	d.Set("slug", *category.Slug)
+	if category.Description != nil {
+		d.Set("description", *category.Description)
+	}
+	d.Set("order_hint", category.OrderHint)
+	d.Set("external_id", category.ExternalID)
+	if category.MetaTitle != nil {
+		d.Set("meta_title", *category.MetaTitle)
+	}
+	if category.MetaDescription != nil {
+		d.Set("meta_description", *category.MetaDescription)
+	}
+	if category.MetaKeywords != nil {
+		d.Set("meta_keywords", *category.MetaKeywords)
+	}
+
+	// The parent is stored the same way as it is configured, either by key
+	// or by id.
+	if category.Parent == nil {
+		d.Set("parent_id", "")
+		d.Set("parent_key", "")
+	} else if d.Get("parent_key").(string) != "" && category.Parent.Obj != nil {
+		d.Set("parent_key", category.Parent.Obj.Key)
+	} else {
+		d.Set("parent_id", category.Parent.ID)
+	}
+
+	custom, err := flattenCustomFields(category.Custom)
+	if err != nil {
+		return err
+	}
+	d.Set("custom", custom)
+
+	return nil
+}
+
+func resourceCategoryUpdate(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+
+	input := &commercetools.CategoryUpdateWithIDInput{
+		ID:      d.Id(),
+		Version: d.Get("version").(int),
+		Actions: []commercetools.CategoryUpdateAction{},
+	}
+
+	if d.HasChange("key") {
+		newKey := d.Get("key").(string)
+		input.Actions = append(
+			input.Actions,
+			&commercetools.CategorySetKeyAction{Key: newKey})
+	}
+
+	if d.HasChange("name") {
+		newName := commercetools.LocalizedString(
+			expandStringMap(d.Get("name").(map[string]interface{})))
+		input.Actions = append(
+			input.Actions,
+			&commercetools.CategoryChangeNameAction{Name: &newName})
+	}
+
+	if d.HasChange("slug") {
+		newSlug := commercetools.LocalizedString(
+			expandStringMap(d.Get("slug").(map[string]interface{})))
+		input.Actions = append(
+			input.Actions,
+			&commercetools.CategoryChangeSlugAction{Slug: &newSlug})
+	}
+
+	if d.HasChange("description") {
+		newDescription := commercetools.LocalizedString(
+			expandStringMap(d.Get("description").(map[string]interface{})))
+		input.Actions = append(
+			input.Actions,
+			&commercetools.CategorySetDescriptionAction{Description: &newDescription})
+	}
+
+	if d.HasChange("parent_id") || d.HasChange("parent_key") {
+		// Removing the parent forces a new resource, see
+		// resourceCategoryCustomizeDiff
+		if parent := resourceCategoryGetParent(d); parent != nil {
+			input.Actions = append(
+				input.Actions,
+				&commercetools.CategoryChangeParentAction{Parent: parent})
+		}
+	}
+
+	if d.HasChange("order_hint") {
+		newOrderHint := d.Get("order_hint").(string)
+		input.Actions = append(
+			input.Actions,
+			&commercetools.CategoryChangeOrderHintAction{OrderHint: newOrderHint})
+	}
+
+	if d.HasChange("external_id") {
+		newExternalID := d.Get("external_id").(string)
+		input.Actions = append(
+			input.Actions,
+			&commercetools.CategorySetExternalIDAction{ExternalID: newExternalID})
+	}
+
+	if d.HasChange("meta_title") {
+		newMetaTitle := commercetools.LocalizedString(
+			expandStringMap(d.Get("meta_title").(map[string]interface{})))
+		input.Actions = append(
+			input.Actions,
+			&commercetools.CategorySetMetaTitleAction{MetaTitle: &newMetaTitle})
+	}
+
+	if d.HasChange("meta_description") {
+		newMetaDescription := commercetools.LocalizedString(
+			expandStringMap(d.Get("meta_description").(map[string]interface{})))
+		input.Actions = append(
+			input.Actions,
+			&commercetools.CategorySetMetaDescriptionAction{MetaDescription: &newMetaDescription})
+	}
+
+	if d.HasChange("meta_keywords") {
+		newMetaKeywords := commercetools.LocalizedString(
+			expandStringMap(d.Get("meta_keywords").(map[string]interface{})))
+		input.Actions = append(
+			input.Actions,
+			&commercetools.CategorySetMetaKeywordsAction{MetaKeywords: &newMetaKeywords})
+	}
+
+	if d.HasChange("custom") {
+		if customFieldsTypeChanged(d) {
+			custom, err := expandCustomFieldsDraft(d)
+			if err != nil {
+				return err
+			}
+			action := &commercetools.CategorySetCustomTypeAction{}
+			if custom != nil {
+				action.Type = custom.Type
+				action.Fields = custom.Fields
+			}
+			input.Actions = append(input.Actions, action)
+		} else {
+			changes, err := customFieldValueChanges(d)
+			if err != nil {
+				return err
+			}
+			names := make([]string, 0, len(changes))
+			for name := range changes {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				input.Actions = append(
+					input.Actions,
+					&commercetools.CategorySetCustomFieldAction{Name: name, Value: changes[name]})
+			}
+		}
+	}
+
+	log.Printf(
+		"[DEBUG] Will perform update operation with the following actions:\n%s",
+		stringFormatActions(input.Actions))
+
+	_, err := client.CategoryUpdateWithID(context.Background(), input)
+	getReadCache(m).invalidate(categoryCacheKey(d.Id()))
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
+		}
+		return err
+	}
+
+	return resourceCategoryRead(d, m)
+}
+
+func resourceCategoryDelete(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+	version := d.Get("version").(int)
+	_, err := client.CategoryDeleteWithID(context.Background(), d.Id(), version)
+	getReadCache(m).invalidate(categoryCacheKey(d.Id()))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// readCategory returns the category, with its parent expanded, from the read
+// cache of the provider. It is prefetched together with the other categories
+// when enabled.
+func readCategory(m interface{}, categoryID string) (*commercetools.Category, error) {
+	client := getClient(m)
+	value, err := readPrefetched(m, "categories", categoryID, func() (interface{}, int, error) {
+		category, err := client.CategoryGetWithID(
+			context.Background(), categoryID,
+			commercetools.WithReferenceExpansion("parent"),
+		)
+		if err != nil {
+			return nil, 0, err
+		}
+		return category, category.Version, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*commercetools.Category), nil
+}
+
+func categoryCacheKey(categoryID string) string {
+	return readCacheKey("categories", categoryID)
+}
+
+func resourceCategoryGetParent(d *schema.ResourceData) *commercetools.CategoryResourceIdentifier {
+	if parentID := d.Get("parent_id").(string); parentID != "" {
+		return &commercetools.CategoryResourceIdentifier{ID: parentID}
+	}
+	if parentKey := d.Get("parent_key").(string); parentKey != "" {
+		return &commercetools.CategoryResourceIdentifier{Key: parentKey}
+	}
+	return nil
+}
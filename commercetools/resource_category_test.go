@@ -0,0 +1,175 @@
+package commercetools
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccCategory_createAndUpdate(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCategoryConfig("Shoes", "commercetools_category.men.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_category.shoes", "name.en", "Shoes",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_category.shoes", "slug.en", "shoes",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_category.shoes", "order_hint", "0.1",
+					),
+					resource.TestCheckResourceAttrPair(
+						"commercetools_category.shoes", "parent_id",
+						"commercetools_category.men", "id",
+					),
+				),
+			},
+			{
+				Config: testAccCategoryConfig("Sneakers", "commercetools_category.women.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_category.shoes", "name.en", "Sneakers",
+					),
+					resource.TestCheckResourceAttrPair(
+						"commercetools_category.shoes", "parent_id",
+						"commercetools_category.women", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCategory_parentKeyAndCustomFields(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCategoryCustomConfig("true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_category.sale", "parent_key", "acctest-category-root",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_category.sale", "custom.0.fields.highlight", "true",
+					),
+				),
+			},
+			{
+				Config: testAccCategoryCustomConfig("false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_category.sale", "custom.0.fields.highlight", "false",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccCategoryConfig(name string, parent string) string {
+	return fmt.Sprintf(`
+	resource "commercetools_category" "men" {
+		key = "acctest-category-men"
+		name = {
+			en = "Men"
+		}
+		slug = {
+			en = "men"
+		}
+	}
+
+	resource "commercetools_category" "women" {
+		key = "acctest-category-women"
+		name = {
+			en = "Women"
+		}
+		slug = {
+			en = "women"
+		}
+	}
+
+	resource "commercetools_category" "shoes" {
+		key = "acctest-category-shoes"
+		name = {
+			en = "%s"
+		}
+		slug = {
+			en = "shoes"
+		}
+		description = {
+			en = "All shoes"
+		}
+		meta_title = {
+			en = "Shoes"
+		}
+		parent_id = %s
+		order_hint = "0.1"
+		external_id = "shoes-1"
+	}`, name, parent)
+}
+
+func testAccCategoryCustomConfig(highlight string) string {
+	return fmt.Sprintf(`
+	resource "commercetools_type" "category" {
+		key = "acctest-category-type"
+		name = {
+			en = "Category type"
+		}
+		resource_type_ids = ["category"]
+
+		field {
+			name = "highlight"
+			label = {
+				en = "Highlight"
+			}
+			type {
+				name = "Boolean"
+			}
+		}
+	}
+
+	resource "commercetools_category" "root" {
+		key = "acctest-category-root"
+		name = {
+			en = "Root"
+		}
+		slug = {
+			en = "root"
+		}
+	}
+
+	resource "commercetools_category" "sale" {
+		key = "acctest-category-sale"
+		name = {
+			en = "Sale"
+		}
+		slug = {
+			en = "sale"
+		}
+		parent_key = commercetools_category.root.key
+
+		custom {
+			type_id = commercetools_type.category.id
+			fields = {
+				highlight = jsonencode(%s)
+			}
+		}
+	}`, highlight)
+}
+
+func testAccCheckCategoryDestroy(s *terraform.State) error {
+	return nil
+}
@@ -0,0 +1,533 @@
+package commercetools
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+// categoryTreeMaxDepth is the maximum number of nested category levels which
+// can be defined in a commercetools_category_tree resource.
+const categoryTreeMaxDepth = 6
+
+func resourceCategoryTree() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceCategoryTreeCreate,
+		Read:          resourceCategoryTreeRead,
+		Update:        resourceCategoryTreeUpdate,
+		Delete:        resourceCategoryTreeDelete,
+		CustomizeDiff: resourceCategoryTreeCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"category": resourceCategoryTreeNodeSchema(1),
+			"category_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// resourceCategoryTreeNodeSchema returns the schema of a category node. The
+// schema is nested recursively since terraform schemas cannot reference
+// themselves.
+func resourceCategoryTreeNodeSchema(depth int) *schema.Schema {
+	nodeSchema := map[string]*schema.Schema{
+		"key": {
+			Type:     schema.TypeString,
+			Required: true,
+		},
+		"name": {
+			Type:     TypeLocalizedString,
+			Required: true,
+		},
+		"slug": {
+			Type:     TypeLocalizedString,
+			Required: true,
+		},
+		"description": {
+			Type:     TypeLocalizedString,
+			Optional: true,
+		},
+		"order_hint": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+	}
+	if depth < categoryTreeMaxDepth {
+		nodeSchema["category"] = resourceCategoryTreeNodeSchema(depth + 1)
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: depth > 1,
+		Required: depth == 1,
+		Elem:     &schema.Resource{Schema: nodeSchema},
+	}
+}
+
+// categoryTreeNode is a single category of the tree, flattened with a
+// reference to the key of the parent.
+type categoryTreeNode struct {
+	Key         string
+	ParentKey   string
+	Name        commercetools.LocalizedString
+	Slug        commercetools.LocalizedString
+	Description commercetools.LocalizedString
+	OrderHint   string
+}
+
+// expandCategoryTree flattens the nested category blocks in topological
+// order, each parent is returned before its children.
+func expandCategoryTree(input []interface{}, parentKey string) []categoryTreeNode {
+	result := []categoryTreeNode{}
+	for _, raw := range input {
+		if raw == nil {
+			continue
+		}
+		i := raw.(map[string]interface{})
+
+		node := categoryTreeNode{
+			Key:         i["key"].(string),
+			ParentKey:   parentKey,
+			Name:        commercetools.LocalizedString(expandStringMap(i["name"].(map[string]interface{}))),
+			Slug:        commercetools.LocalizedString(expandStringMap(i["slug"].(map[string]interface{}))),
+			Description: commercetools.LocalizedString(expandStringMap(i["description"].(map[string]interface{}))),
+			OrderHint:   i["order_hint"].(string),
+		}
+		result = append(result, node)
+
+		if children, ok := i["category"].([]interface{}); ok {
+			result = append(result, expandCategoryTree(children, node.Key)...)
+		}
+	}
+	return result
+}
+
+func resourceCategoryTreeCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	keys := make(map[string]bool)
+	for _, node := range expandCategoryTree(d.Get("category").([]interface{}), "") {
+		if node.Key == "" {
+			// The key is not known yet, it will be validated during apply
+			continue
+		}
+		if keys[node.Key] {
+			return fmt.Errorf("Category key %s is used more than once in the tree", node.Key)
+		}
+		keys[node.Key] = true
+	}
+	return nil
+}
+
+func resourceCategoryTreeCreate(d *schema.ResourceData, m interface{}) error {
+	d.SetId(resource.PrefixedUniqueId("category-tree-"))
+	d.Set("category_ids", map[string]string{})
+
+	err := resourceCategoryTreeApply(d, m)
+	if err != nil {
+		return err
+	}
+
+	return resourceCategoryTreeRead(d, m)
+}
+
+func resourceCategoryTreeRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Reading category tree from commercetools, with id: %s", d.Id())
+	client := getClient(m)
+
+	categoryIDs := expandStringMap(d.Get("category_ids").(map[string]interface{}))
+	categories, err := resourceCategoryTreeFetch(client, categoryIDs)
+	if err != nil {
+		return err
+	}
+
+	// Use the position of the nodes in the current state to order the
+	// children, so the order matches the configuration.
+	positions := make(map[string]int)
+	for i, node := range expandCategoryTree(d.Get("category").([]interface{}), "") {
+		positions[node.Key] = i
+	}
+
+	children := make(map[string][]*commercetools.Category)
+	newCategoryIDs := make(map[string]string)
+	for _, category := range categories {
+		newCategoryIDs[category.Key] = category.ID
+		parentKey := ""
+		if category.Parent != nil {
+			if category.Parent.Obj == nil {
+				// The parent is outside of the tree, skip the category so
+				// it is moved back into the tree on the next apply.
+				continue
+			}
+			parentKey = category.Parent.Obj.Key
+		}
+		children[parentKey] = append(children[parentKey], category)
+	}
+	for parentKey := range children {
+		items := children[parentKey]
+		sort.SliceStable(items, func(i, j int) bool {
+			pi, iok := positions[items[i].Key]
+			pj, jok := positions[items[j].Key]
+			if iok && jok {
+				return pi < pj
+			}
+			if iok != jok {
+				return iok
+			}
+			return items[i].OrderHint < items[j].OrderHint
+		})
+	}
+
+	d.Set("category_ids", newCategoryIDs)
+	if err := d.Set("category", flattenCategoryTree(children, "", 1)); err != nil {
+		return err
+	}
+	return nil
+}
+
+func flattenCategoryTree(children map[string][]*commercetools.Category, parentKey string, depth int) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, category := range children[parentKey] {
+		node := map[string]interface{}{
+			"key":        category.Key,
+			"name":       localizedStringToMap(*category.Name),
+			"slug":       localizedStringToMap(*category.Slug),
+			"order_hint": category.OrderHint,
+		}
+		if category.Description != nil {
+			node["description"] = localizedStringToMap(*category.Description)
+		}
+		if depth < categoryTreeMaxDepth {
+			node["category"] = flattenCategoryTree(children, category.Key, depth+1)
+		}
+		result = append(result, node)
+	}
+	return result
+}
+
+func resourceCategoryTreeUpdate(d *schema.ResourceData, m interface{}) error {
+	// Only the category ids are stored when the update fails halfway, the
+	// tree is updated after all changes are applied.
+	d.Partial(true)
+	d.SetPartial("category_ids")
+
+	err := resourceCategoryTreeApply(d, m)
+	if err != nil {
+		return err
+	}
+
+	d.Partial(false)
+	return resourceCategoryTreeRead(d, m)
+}
+
+// resourceCategoryTreeApply creates, moves and updates the categories in
+// topological order and then removes the categories which are no longer part
+// of the tree, deepest categories first. Slugs of removed categories which
+// are reused in the tree are released before the categories are created.
+func resourceCategoryTreeApply(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+
+	categoryIDs := expandStringMap(d.Get("category_ids").(map[string]interface{}))
+	categories, err := resourceCategoryTreeFetch(client, categoryIDs)
+	if err != nil {
+		return err
+	}
+	current := make(map[string]*commercetools.Category, len(categories))
+	for _, category := range categories {
+		current[category.Key] = category
+	}
+
+	// Record the progress after every change, so a failure halfway doesn't
+	// leave untracked categories behind.
+	setCategoryID := func(key string, id string) {
+		if id == "" {
+			delete(categoryIDs, key)
+		} else {
+			categoryIDs[key] = id
+		}
+		d.Set("category_ids", categoryIDs)
+	}
+
+	nodes := expandCategoryTree(d.Get("category").([]interface{}), "")
+	wanted := make(map[string]bool, len(nodes))
+	for _, node := range nodes {
+		wanted[node.Key] = true
+	}
+
+	// The removed categories are deleted after the other categories are
+	// created and moved, so their children can be moved first. Slugs must be
+	// unique, so the slugs of removed categories which are used by another
+	// node are changed beforehand.
+	for _, category := range categories {
+		if wanted[category.Key] {
+			continue
+		}
+		input := resourceCategoryTreeReleaseSlugActions(category, nodes)
+		if input == nil {
+			continue
+		}
+
+		log.Printf(
+			"[DEBUG] Will perform update operation on removed category %s with the following actions:\n%s",
+			category.Key, stringFormatActions(input.Actions))
+
+		_, err = client.CategoryUpdateWithID(context.Background(), input)
+		getReadCache(m).invalidate(categoryCacheKey(input.ID))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, node := range nodes {
+		category, exists := current[node.Key]
+
+		if !exists {
+			category, err := resourceCategoryTreeCreateNode(client, node)
+			if err != nil {
+				return err
+			}
+			setCategoryID(node.Key, category.ID)
+			continue
+		}
+
+		input, err := resourceCategoryTreeNodeActions(node, category)
+		if err != nil {
+			return err
+		}
+		if len(input.Actions) == 0 {
+			continue
+		}
+
+		log.Printf(
+			"[DEBUG] Will perform update operation on category %s with the following actions:\n%s",
+			node.Key, stringFormatActions(input.Actions))
+
+		_, err = client.CategoryUpdateWithID(context.Background(), input)
+		getReadCache(m).invalidate(categoryCacheKey(input.ID))
+		if err != nil {
+			if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+				log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
+			}
+			return err
+		}
+	}
+
+	// Fetch the categories again, the versions and ancestors changed when
+	// categories were moved
+	categories, err = resourceCategoryTreeFetch(client, categoryIDs)
+	if err != nil {
+		return err
+	}
+	removed := []*commercetools.Category{}
+	for _, category := range categories {
+		if !wanted[category.Key] {
+			removed = append(removed, category)
+		}
+	}
+	sort.SliceStable(removed, func(i, j int) bool {
+		return len(removed[i].Ancestors) > len(removed[j].Ancestors)
+	})
+
+	for _, category := range removed {
+		log.Printf("[DEBUG] Removing category %s from the tree", category.Key)
+		_, err := client.CategoryDeleteWithID(context.Background(), category.ID, category.Version)
+		getReadCache(m).invalidate(categoryCacheKey(category.ID))
+		if err != nil {
+			return err
+		}
+		setCategoryID(category.Key, "")
+	}
+
+	return nil
+}
+
+func resourceCategoryTreeCreateNode(client *commercetools.Client, node categoryTreeNode) (*commercetools.Category, error) {
+	draft := &commercetools.CategoryDraft{
+		Key:         node.Key,
+		Name:        &node.Name,
+		Slug:        &node.Slug,
+		Description: &node.Description,
+		OrderHint:   node.OrderHint,
+	}
+	if node.ParentKey != "" {
+		draft.Parent = &commercetools.CategoryResourceIdentifier{Key: node.ParentKey}
+	}
+
+	log.Printf("[DEBUG] Creating category %s in the tree", node.Key)
+
+	var category *commercetools.Category
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+
+		category, err = client.CategoryCreate(context.Background(), draft)
+		if err != nil {
+			return handleCommercetoolsError(err)
+		}
+		return nil
+	})
+	return category, err
+}
+
+// resourceCategoryTreeReleaseSlugActions returns the actions to change the
+// slugs of a removed category which are used by one of the nodes, or nil when
+// none of its slugs are used. The slugs are replaced by a value based on the
+// id of the category, which is unique.
+func resourceCategoryTreeReleaseSlugActions(category *commercetools.Category, nodes []categoryTreeNode) *commercetools.CategoryUpdateWithIDInput {
+	if category.Slug == nil {
+		return nil
+	}
+
+	slug := commercetools.LocalizedString{}
+	changed := false
+	for locale, value := range *category.Slug {
+		slug[locale] = value
+		for _, node := range nodes {
+			if node.Slug[locale] == value {
+				slug[locale] = fmt.Sprintf("removed-%s", category.ID)
+				changed = true
+				break
+			}
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	return &commercetools.CategoryUpdateWithIDInput{
+		ID:      category.ID,
+		Version: category.Version,
+		Actions: []commercetools.CategoryUpdateAction{
+			&commercetools.CategoryChangeSlugAction{Slug: &slug},
+		},
+	}
+}
+
+func resourceCategoryTreeNodeActions(node categoryTreeNode, category *commercetools.Category) (*commercetools.CategoryUpdateWithIDInput, error) {
+	input := &commercetools.CategoryUpdateWithIDInput{
+		ID:      category.ID,
+		Version: category.Version,
+		Actions: []commercetools.CategoryUpdateAction{},
+	}
+
+	currentParentKey := ""
+	if category.Parent != nil {
+		if category.Parent.Obj != nil {
+			currentParentKey = category.Parent.Obj.Key
+		} else {
+			currentParentKey = category.Parent.ID
+		}
+	}
+	if currentParentKey != node.ParentKey {
+		if node.ParentKey == "" {
+			return nil, fmt.Errorf(
+				"Category %s cannot be moved to the root level of the tree, "+
+					"commercetools does not support removing the parent of a category", node.Key)
+		}
+		input.Actions = append(
+			input.Actions,
+			&commercetools.CategoryChangeParentAction{
+				Parent: &commercetools.CategoryResourceIdentifier{Key: node.ParentKey},
+			})
+	}
+
+	if !localizedStringEqual(category.Name, node.Name) {
+		input.Actions = append(
+			input.Actions,
+			&commercetools.CategoryChangeNameAction{Name: &node.Name})
+	}
+
+	if !localizedStringEqual(category.Slug, node.Slug) {
+		input.Actions = append(
+			input.Actions,
+			&commercetools.CategoryChangeSlugAction{Slug: &node.Slug})
+	}
+
+	if !localizedStringEqual(category.Description, node.Description) {
+		input.Actions = append(
+			input.Actions,
+			&commercetools.CategorySetDescriptionAction{Description: &node.Description})
+	}
+
+	if node.OrderHint != "" && node.OrderHint != category.OrderHint {
+		input.Actions = append(
+			input.Actions,
+			&commercetools.CategoryChangeOrderHintAction{OrderHint: node.OrderHint})
+	}
+
+	return input, nil
+}
+
+func resourceCategoryTreeDelete(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+
+	categoryIDs := expandStringMap(d.Get("category_ids").(map[string]interface{}))
+	categories, err := resourceCategoryTreeFetch(client, categoryIDs)
+	if err != nil {
+		return err
+	}
+
+	// Delete the deepest categories first
+	sort.SliceStable(categories, func(i, j int) bool {
+		return len(categories[i].Ancestors) > len(categories[j].Ancestors)
+	})
+	for _, category := range categories {
+		_, err := client.CategoryDeleteWithID(context.Background(), category.ID, category.Version)
+		getReadCache(m).invalidate(categoryCacheKey(category.ID))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resourceCategoryTreeFetch queries the categories with the given ids in
+// batches, with the parent reference expanded.
+func resourceCategoryTreeFetch(client *commercetools.Client, categoryIDs map[string]string) ([]*commercetools.Category, error) {
+	ids := make([]string, 0, len(categoryIDs))
+	for _, id := range categoryIDs {
+		ids = append(ids, fmt.Sprintf("%q", id))
+	}
+	sort.Strings(ids)
+
+	result := []*commercetools.Category{}
+	batchSize := 100
+	for start := 0; start < len(ids); start += batchSize {
+		end := start + batchSize
+		if end > len(ids) {
+			end = len(ids)
+		}
+
+		queryInput := commercetools.QueryInput{
+			Where:  fmt.Sprintf("id in (%s)", strings.Join(ids[start:end], ", ")),
+			Expand: "parent",
+			Limit:  batchSize,
+		}
+		response, err := client.CategoryQuery(context.Background(), &queryInput)
+		if err != nil {
+			return nil, err
+		}
+		for i := range response.Results {
+			result = append(result, &response.Results[i])
+		}
+	}
+	return result, nil
+}
+
+func localizedStringEqual(a *commercetools.LocalizedString, b commercetools.LocalizedString) bool {
+	if a == nil {
+		return len(b) == 0
+	}
+	if len(*a) == 0 && len(b) == 0 {
+		return true
+	}
+	return reflect.DeepEqual(map[string]string(*a), map[string]string(b))
+}
@@ -0,0 +1,181 @@
+package commercetools
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandCategoryTree(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"key":         "men",
+			"name":        map[string]interface{}{"en": "Men"},
+			"slug":        map[string]interface{}{"en": "men"},
+			"description": map[string]interface{}{},
+			"order_hint":  "0.1",
+			"category": []interface{}{
+				map[string]interface{}{
+					"key":         "men-shoes",
+					"name":        map[string]interface{}{"en": "Shoes"},
+					"slug":        map[string]interface{}{"en": "men-shoes"},
+					"description": map[string]interface{}{},
+					"order_hint":  "",
+					"category":    []interface{}{},
+				},
+			},
+		},
+		map[string]interface{}{
+			"key":         "women",
+			"name":        map[string]interface{}{"en": "Women"},
+			"slug":        map[string]interface{}{"en": "women"},
+			"description": map[string]interface{}{},
+			"order_hint":  "0.2",
+			"category":    []interface{}{},
+		},
+	}
+
+	nodes := expandCategoryTree(input, "")
+	assert.Len(t, nodes, 3)
+	assert.Equal(t, "men", nodes[0].Key)
+	assert.Equal(t, "", nodes[0].ParentKey)
+	assert.Equal(t, "men-shoes", nodes[1].Key)
+	assert.Equal(t, "men", nodes[1].ParentKey)
+	assert.Equal(t, "women", nodes[2].Key)
+	assert.Equal(t, "0.2", nodes[2].OrderHint)
+}
+
+func TestResourceCategoryTreeReleaseSlugActions(t *testing.T) {
+	nodes := []categoryTreeNode{
+		{Key: "shoes", Slug: commercetools.LocalizedString{"en": "shoes", "nl": "schoenen"}},
+	}
+
+	removed := &commercetools.Category{
+		ID:      "category-id",
+		Version: 2,
+		Key:     "old-shoes",
+		Slug:    &commercetools.LocalizedString{"en": "shoes", "nl": "oude-schoenen"},
+	}
+	input := resourceCategoryTreeReleaseSlugActions(removed, nodes)
+	assert.Equal(t, "category-id", input.ID)
+	assert.Equal(t, 2, input.Version)
+	assert.Equal(t, []commercetools.CategoryUpdateAction{
+		&commercetools.CategoryChangeSlugAction{
+			Slug: &commercetools.LocalizedString{"en": "removed-category-id", "nl": "oude-schoenen"},
+		},
+	}, input.Actions)
+
+	unrelated := &commercetools.Category{
+		ID:   "other-id",
+		Key:  "boots",
+		Slug: &commercetools.LocalizedString{"en": "boots"},
+	}
+	assert.Nil(t, resourceCategoryTreeReleaseSlugActions(unrelated, nodes))
+}
+
+func TestAccCategoryTree_createAndMove(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckCategoryTreeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCategoryTreeConfig("men", "acctest-tree-shoes"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_category_tree.navigation", "category.#", "2",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_category_tree.navigation", "category.0.category.0.key", "acctest-tree-shoes",
+					),
+					resource.TestCheckResourceAttrSet(
+						"commercetools_category_tree.navigation", "category_ids.acctest-tree-shoes",
+					),
+				),
+			},
+			{
+				Config: testAccCategoryTreeConfig("women", "acctest-tree-shoes"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_category_tree.navigation", "category.0.category.#", "0",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_category_tree.navigation", "category.1.category.0.key", "acctest-tree-shoes",
+					),
+				),
+			},
+			{
+				// A new key with the slug of the removed category
+				Config: testAccCategoryTreeConfig("women", "acctest-tree-footwear"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_category_tree.navigation", "category.1.category.0.key", "acctest-tree-footwear",
+					),
+					resource.TestCheckResourceAttrSet(
+						"commercetools_category_tree.navigation", "category_ids.acctest-tree-footwear",
+					),
+					resource.TestCheckNoResourceAttr(
+						"commercetools_category_tree.navigation", "category_ids.acctest-tree-shoes",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccCategoryTreeConfig(shoesParent string, shoesKey string) string {
+	menChildren := ""
+	womenChildren := ""
+	shoes := fmt.Sprintf(`
+			category {
+				key = "%s"
+				name = {
+					en = "Shoes"
+				}
+				slug = {
+					en = "acctest-tree-shoes"
+				}
+				order_hint = "0.5"
+			}`, shoesKey)
+	if shoesParent == "men" {
+		menChildren = shoes
+	} else {
+		womenChildren = shoes
+	}
+
+	return fmt.Sprintf(`
+	resource "commercetools_category_tree" "navigation" {
+		category {
+			key = "acctest-tree-men"
+			name = {
+				en = "Men"
+			}
+			slug = {
+				en = "acctest-tree-men"
+			}
+			order_hint = "0.1"
+			%s
+		}
+
+		category {
+			key = "acctest-tree-women"
+			name = {
+				en = "Women"
+			}
+			slug = {
+				en = "acctest-tree-women"
+			}
+			order_hint = "0.2"
+			%s
+		}
+	}`, menChildren, womenChildren)
+}
+
+func testAccCheckCategoryTreeDestroy(s *terraform.State) error {
+	return nil
+}
@@ -0,0 +1,194 @@
+package commercetools
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+func resourceInventoryEntry() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceInventoryEntryCreate,
+		Read:   resourceInventoryEntryRead,
+		Update: resourceInventoryEntryUpdate,
+		Delete: resourceInventoryEntryDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"sku": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"supply_channel_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"quantity_on_stock": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"restockable_in_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"expected_delivery": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: diffSuppressDate,
+			},
+			"available_quantity": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceInventoryEntryCreate(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+	var inventoryEntry *commercetools.InventoryEntry
+
+	draft := &commercetools.InventoryEntryDraft{
+		SKU:               d.Get("sku").(string),
+		QuantityOnStock:   d.Get("quantity_on_stock").(int),
+		RestockableInDays: d.Get("restockable_in_days").(int),
+	}
+
+	if supplyChannelID := d.Get("supply_channel_id").(string); supplyChannelID != "" {
+		draft.SupplyChannel = &commercetools.ChannelResourceIdentifier{ID: supplyChannelID}
+	}
+
+	if val := d.Get("expected_delivery").(string); val != "" {
+		expectedDelivery, err := expandDate(val)
+		if err != nil {
+			return err
+		}
+		draft.ExpectedDelivery = &expectedDelivery
+	}
+
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+
+		inventoryEntry, err = client.InventoryEntryCreate(context.Background(), draft)
+		if err != nil {
+			return handleCommercetoolsError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(inventoryEntry.ID)
+	d.Set("version", inventoryEntry.Version)
+
+	return resourceInventoryEntryRead(d, m)
+}
+
+func resourceInventoryEntryRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Reading inventory entry from commercetools, with inventory entry id: %s", d.Id())
+	client := getClient(m)
+
+	inventoryEntry, err := client.InventoryEntryGetWithID(context.Background(), d.Id())
+
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			if ctErr.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	log.Print("[DEBUG] Found following inventory entry:")
+	log.Print(stringFormatObject(inventoryEntry))
+
+	d.Set("version", inventoryEntry.Version)
+	d.Set("sku", inventoryEntry.SKU)
+	if inventoryEntry.SupplyChannel != nil {
+		d.Set("supply_channel_id", inventoryEntry.SupplyChannel.ID)
+	} else {
+		d.Set("supply_channel_id", "")
+	}
+	d.Set("quantity_on_stock", inventoryEntry.QuantityOnStock)
+	d.Set("available_quantity", inventoryEntry.AvailableQuantity)
+	d.Set("restockable_in_days", inventoryEntry.RestockableInDays)
+	d.Set("expected_delivery", flattenDate(inventoryEntry.ExpectedDelivery))
+
+	return nil
+}
+
+func resourceInventoryEntryUpdate(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+
+	input := &commercetools.InventoryEntryUpdateWithIDInput{
+		ID:      d.Id(),
+		Version: d.Get("version").(int),
+		Actions: []commercetools.InventoryEntryUpdateAction{},
+	}
+
+	if d.HasChange("quantity_on_stock") {
+		newQuantity := d.Get("quantity_on_stock").(int)
+		input.Actions = append(
+			input.Actions,
+			&commercetools.InventoryEntryChangeQuantityAction{Quantity: newQuantity})
+	}
+
+	if d.HasChange("restockable_in_days") {
+		newRestockableInDays := d.Get("restockable_in_days").(int)
+		input.Actions = append(
+			input.Actions,
+			&commercetools.InventoryEntrySetRestockableInDaysAction{RestockableInDays: newRestockableInDays})
+	}
+
+	if d.HasChange("expected_delivery") {
+		action := &commercetools.InventoryEntrySetExpectedDeliveryAction{}
+		if val := d.Get("expected_delivery").(string); val != "" {
+			expectedDelivery, err := expandDate(val)
+			if err != nil {
+				return err
+			}
+			action.ExpectedDelivery = &expectedDelivery
+		}
+		input.Actions = append(input.Actions, action)
+	}
+
+	log.Printf(
+		"[DEBUG] Will perform update operation with the following actions:\n%s",
+		stringFormatActions(input.Actions))
+
+	_, err := client.InventoryEntryUpdateWithID(context.Background(), input)
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
+		}
+		return err
+	}
+
+	return resourceInventoryEntryRead(d, m)
+}
+
+func resourceInventoryEntryDelete(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+	version := d.Get("version").(int)
+	_, err := client.InventoryEntryDeleteWithID(context.Background(), d.Id(), version)
+	if err != nil {
+		return err
+	}
+	return nil
+}
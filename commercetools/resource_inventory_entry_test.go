@@ -0,0 +1,79 @@
+package commercetools
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccInventoryEntryCreate_basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckInventoryEntryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInventoryEntryConfig(10, 3, `expected_delivery = "2030-01-02T15:04:05.000Z"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_inventory_entry.standard", "sku", "test-sku",
+					),
+					resource.TestCheckResourceAttrPair(
+						"commercetools_inventory_entry.standard", "supply_channel_id",
+						"commercetools_channel.warehouse", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_inventory_entry.standard", "quantity_on_stock", "10",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_inventory_entry.standard", "available_quantity", "10",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_inventory_entry.standard", "restockable_in_days", "3",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_inventory_entry.standard", "expected_delivery", "2030-01-02T15:04:05Z",
+					),
+				),
+			},
+			{
+				Config: testAccInventoryEntryConfig(25, 0, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_inventory_entry.standard", "quantity_on_stock", "25",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_inventory_entry.standard", "restockable_in_days", "0",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_inventory_entry.standard", "expected_delivery", "",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccInventoryEntryConfig(quantity int, restockableInDays int, extra string) string {
+	return fmt.Sprintf(`
+	resource "commercetools_channel" "warehouse" {
+		key = "inventory-warehouse"
+		roles = ["InventorySupply"]
+	}
+
+	resource "commercetools_inventory_entry" "standard" {
+		sku = "test-sku"
+		supply_channel_id = commercetools_channel.warehouse.id
+		quantity_on_stock = %d
+		restockable_in_days = %d
+		%s
+	}
+	`, quantity, restockableInDays, extra)
+}
+
+func testAccCheckInventoryEntryDestroy(s *terraform.State) error {
+	return nil
+}
@@ -0,0 +1,784 @@
+package commercetools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+func resourceProduct() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProductCreate,
+		Read:   resourceProductRead,
+		Update: resourceProductUpdate,
+		Delete: resourceProductDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"product_type_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"name": {
+				Type:     TypeLocalizedString,
+				Required: true,
+			},
+			"slug": {
+				Type:     TypeLocalizedString,
+				Required: true,
+			},
+			"description": {
+				Type:     TypeLocalizedString,
+				Optional: true,
+			},
+			"categories": {
+				Type:     schema.TypeSet,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+				Optional: true,
+			},
+			"tax_category_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"master_variant": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem:     productVariantSchema(),
+			},
+			"variant": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     productVariantSchema(),
+			},
+			"publish": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func productVariantSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"sku": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"attributes": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"price": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"currency_code": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateCurrencyCode,
+						},
+						"cent_amount": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+						"country": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"customer_group_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"channel_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"valid_from": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: diffSuppressDate,
+						},
+						"valid_until": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							DiffSuppressFunc: diffSuppressDate,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceProductCreate(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+	var product *commercetools.Product
+
+	name := commercetools.LocalizedString(
+		expandStringMap(d.Get("name").(map[string]interface{})))
+	slug := commercetools.LocalizedString(
+		expandStringMap(d.Get("slug").(map[string]interface{})))
+	description := commercetools.LocalizedString(
+		expandStringMap(d.Get("description").(map[string]interface{})))
+
+	masterVariant, err := expandProductVariantDraft(
+		d.Get("master_variant").([]interface{})[0].(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+
+	variants := []commercetools.ProductVariantDraft{}
+	for _, raw := range d.Get("variant").([]interface{}) {
+		variant, err := expandProductVariantDraft(raw.(map[string]interface{}))
+		if err != nil {
+			return err
+		}
+		variants = append(variants, variant)
+	}
+
+	draft := &commercetools.ProductDraft{
+		Key:           d.Get("key").(string),
+		ProductType:   &commercetools.ProductTypeResourceIdentifier{ID: d.Get("product_type_id").(string)},
+		Name:          &name,
+		Slug:          &slug,
+		Description:   &description,
+		Categories:    expandProductCategories(d.Get("categories").(*schema.Set)),
+		MasterVariant: &masterVariant,
+		Variants:      variants,
+		Publish:       d.Get("publish").(bool),
+	}
+
+	if taxCategoryID := d.Get("tax_category_id").(string); taxCategoryID != "" {
+		draft.TaxCategory = &commercetools.TaxCategoryResourceIdentifier{ID: taxCategoryID}
+	}
+
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+
+		product, err = client.ProductCreate(context.Background(), draft)
+		if err != nil {
+			return handleCommercetoolsError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(product.ID)
+	d.Set("version", product.Version)
+
+	return resourceProductRead(d, m)
+}
+
+func resourceProductRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Reading product from commercetools, with product id: %s", d.Id())
+	client := getClient(m)
+
+	product, err := client.ProductGetWithID(context.Background(), d.Id())
+
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			if ctErr.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	log.Print("[DEBUG] Found following product:")
+	log.Print(stringFormatObject(product))
+
+	// All changes are staged, so the staged data reflects the configuration
+	// both for published and unpublished products.
+	data := product.MasterData.Staged
+
+	d.Set("version", product.Version)
+	d.Set("key", product.Key)
+	if product.ProductType != nil {
+		d.Set("product_type_id", product.ProductType.ID)
+	}
+	if product.TaxCategory != nil {
+		d.Set("tax_category_id", product.TaxCategory.ID)
+	} else {
+		d.Set("tax_category_id", "")
+	}
+	d.Set("name", *data.Name)
+	d.Set("slug", *data.Slug)
+	if data.Description != nil {
+		d.Set("description", *data.Description)
+	}
+
+	categories := make([]string, 0, len(data.Categories))
+	for _, category := range data.Categories {
+		categories = append(categories, category.ID)
+	}
+	d.Set("categories", categories)
+
+	stateVariants, variantOrder := productStateVariants(d)
+
+	masterVariant, err := flattenProductVariant(data.MasterVariant, stateVariants)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("master_variant", []map[string]interface{}{masterVariant}); err != nil {
+		return err
+	}
+
+	variants := make([]map[string]interface{}, 0, len(data.Variants))
+	for i := range data.Variants {
+		variant, err := flattenProductVariant(&data.Variants[i], stateVariants)
+		if err != nil {
+			return err
+		}
+		variants = append(variants, variant)
+	}
+	sort.SliceStable(variants, func(i, j int) bool {
+		return stateOrder(variantOrder, variants[i]["sku"].(string)) <
+			stateOrder(variantOrder, variants[j]["sku"].(string))
+	})
+	if err := d.Set("variant", variants); err != nil {
+		return err
+	}
+
+	d.Set("publish", product.MasterData.Published)
+
+	return nil
+}
+
+func resourceProductUpdate(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+
+	input := &commercetools.ProductUpdateWithIDInput{
+		ID:      d.Id(),
+		Version: d.Get("version").(int),
+		Actions: []commercetools.ProductUpdateAction{},
+	}
+
+	if d.HasChange("key") {
+		newKey := d.Get("key").(string)
+		input.Actions = append(
+			input.Actions,
+			&commercetools.ProductSetKeyAction{Key: newKey})
+	}
+
+	if d.HasChange("name") {
+		newName := commercetools.LocalizedString(
+			expandStringMap(d.Get("name").(map[string]interface{})))
+		input.Actions = append(
+			input.Actions,
+			&commercetools.ProductChangeNameAction{Name: &newName, Staged: true})
+	}
+
+	if d.HasChange("slug") {
+		newSlug := commercetools.LocalizedString(
+			expandStringMap(d.Get("slug").(map[string]interface{})))
+		input.Actions = append(
+			input.Actions,
+			&commercetools.ProductChangeSlugAction{Slug: &newSlug, Staged: true})
+	}
+
+	if d.HasChange("description") {
+		newDescription := commercetools.LocalizedString(
+			expandStringMap(d.Get("description").(map[string]interface{})))
+		input.Actions = append(
+			input.Actions,
+			&commercetools.ProductSetDescriptionAction{Description: &newDescription, Staged: true})
+	}
+
+	if d.HasChange("categories") {
+		old, new := d.GetChange("categories")
+		oldCategories := old.(*schema.Set)
+		newCategories := new.(*schema.Set)
+
+		for _, category := range expandProductCategories(newCategories.Difference(oldCategories)) {
+			category := category
+			input.Actions = append(
+				input.Actions,
+				&commercetools.ProductAddToCategoryAction{Category: &category, Staged: true})
+		}
+		for _, category := range expandProductCategories(oldCategories.Difference(newCategories)) {
+			category := category
+			input.Actions = append(
+				input.Actions,
+				&commercetools.ProductRemoveFromCategoryAction{Category: &category, Staged: true})
+		}
+	}
+
+	if d.HasChange("tax_category_id") {
+		action := &commercetools.ProductSetTaxCategoryAction{}
+		if taxCategoryID := d.Get("tax_category_id").(string); taxCategoryID != "" {
+			action.TaxCategory = &commercetools.TaxCategoryResourceIdentifier{ID: taxCategoryID}
+		}
+		input.Actions = append(input.Actions, action)
+	}
+
+	if d.HasChange("master_variant") || d.HasChange("variant") {
+		actions, err := resourceProductVariantActions(d)
+		if err != nil {
+			return err
+		}
+		input.Actions = append(input.Actions, actions...)
+	}
+
+	// Staged changes are published directly when the product should be
+	// published
+	if d.Get("publish").(bool) {
+		if len(input.Actions) > 0 || d.HasChange("publish") {
+			input.Actions = append(input.Actions, &commercetools.ProductPublishAction{})
+		}
+	} else if d.HasChange("publish") {
+		input.Actions = append(input.Actions, &commercetools.ProductUnpublishAction{})
+	}
+
+	log.Printf(
+		"[DEBUG] Will perform update operation with the following actions:\n%s",
+		stringFormatActions(input.Actions))
+
+	_, err := client.ProductUpdateWithID(context.Background(), input)
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
+		}
+		return err
+	}
+
+	return resourceProductRead(d, m)
+}
+
+func resourceProductDelete(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+	version := d.Get("version").(int)
+
+	// A published product needs to be unpublished before it can be deleted
+	if d.Get("publish").(bool) {
+		product, err := client.ProductUpdateWithID(context.Background(), &commercetools.ProductUpdateWithIDInput{
+			ID:      d.Id(),
+			Version: version,
+			Actions: []commercetools.ProductUpdateAction{
+				&commercetools.ProductUnpublishAction{},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		version = product.Version
+	}
+
+	_, err := client.ProductDeleteWithID(context.Background(), d.Id(), version)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// resourceProductVariantActions returns the staged update actions for the
+// variants. Variants are matched on their sku, so changing the sku of a
+// variant replaces the variant.
+func resourceProductVariantActions(d *schema.ResourceData) ([]commercetools.ProductUpdateAction, error) {
+	oldMaster, newMaster := d.GetChange("master_variant")
+	oldVariants, newVariants := d.GetChange("variant")
+
+	oldList := []interface{}{}
+	oldList = append(oldList, oldMaster.([]interface{})...)
+	oldList = append(oldList, oldVariants.([]interface{})...)
+	newList := []interface{}{}
+	newList = append(newList, newMaster.([]interface{})...)
+	newList = append(newList, newVariants.([]interface{})...)
+
+	oldBySKU := make(map[string]map[string]interface{}, len(oldList))
+	for _, raw := range oldList {
+		variant := raw.(map[string]interface{})
+		oldBySKU[variant["sku"].(string)] = variant
+	}
+	newBySKU := make(map[string]map[string]interface{}, len(newList))
+	for _, raw := range newList {
+		variant := raw.(map[string]interface{})
+		newBySKU[variant["sku"].(string)] = variant
+	}
+
+	actions := []commercetools.ProductUpdateAction{}
+	for _, raw := range newList {
+		newVariant := raw.(map[string]interface{})
+		sku := newVariant["sku"].(string)
+
+		oldVariant, ok := oldBySKU[sku]
+		if !ok {
+			draft, err := expandProductVariantDraft(newVariant)
+			if err != nil {
+				return nil, err
+			}
+			actions = append(actions, &commercetools.ProductAddVariantAction{
+				SKU:        draft.SKU,
+				Key:        draft.Key,
+				Prices:     draft.Prices,
+				Attributes: draft.Attributes,
+				Staged:     true,
+			})
+			continue
+		}
+
+		if oldVariant["key"] != newVariant["key"] {
+			actions = append(actions, &commercetools.ProductSetProductVariantKeyAction{
+				SKU:    sku,
+				Key:    newVariant["key"].(string),
+				Staged: true,
+			})
+		}
+
+		attributeChanges, err := productAttributeChanges(
+			oldVariant["attributes"].(map[string]interface{}),
+			newVariant["attributes"].(map[string]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(attributeChanges))
+		for name := range attributeChanges {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			actions = append(actions, &commercetools.ProductSetAttributeAction{
+				SKU:    sku,
+				Name:   name,
+				Value:  attributeChanges[name],
+				Staged: true,
+			})
+		}
+
+		oldPrices, err := expandProductPriceDrafts(oldVariant["price"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		newPrices, err := expandProductPriceDrafts(newVariant["price"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(oldPrices, newPrices) {
+			actions = append(actions, &commercetools.ProductSetPricesAction{
+				SKU:    sku,
+				Prices: newPrices,
+				Staged: true,
+			})
+		}
+	}
+
+	oldMasterSKU := oldMaster.([]interface{})[0].(map[string]interface{})["sku"].(string)
+	newMasterSKU := newMaster.([]interface{})[0].(map[string]interface{})["sku"].(string)
+	if oldMasterSKU != newMasterSKU {
+		actions = append(actions, &commercetools.ProductChangeMasterVariantAction{
+			SKU:    newMasterSKU,
+			Staged: true,
+		})
+	}
+
+	for _, raw := range oldList {
+		sku := raw.(map[string]interface{})["sku"].(string)
+		if _, ok := newBySKU[sku]; !ok {
+			actions = append(actions, &commercetools.ProductRemoveVariantAction{
+				SKU:    sku,
+				Staged: true,
+			})
+		}
+	}
+
+	return actions, nil
+}
+
+// productAttributeChanges returns the attributes which need to be updated,
+// removed attributes have a nil value.
+func productAttributeChanges(oldAttributes map[string]interface{}, newAttributes map[string]interface{}) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	for name, raw := range newAttributes {
+		if oldValue, ok := oldAttributes[name]; ok && oldValue == raw {
+			continue
+		}
+		value, err := expandProductAttributeValue(name, raw.(string))
+		if err != nil {
+			return nil, err
+		}
+		result[name] = value
+	}
+	for name := range oldAttributes {
+		if _, ok := newAttributes[name]; !ok {
+			result[name] = nil
+		}
+	}
+	return result, nil
+}
+
+func expandProductCategories(input *schema.Set) []commercetools.CategoryResourceIdentifier {
+	ids := expandStringArray(input.List())
+	sort.Strings(ids)
+
+	result := make([]commercetools.CategoryResourceIdentifier, 0, len(ids))
+	for _, id := range ids {
+		result = append(result, commercetools.CategoryResourceIdentifier{ID: id})
+	}
+	return result
+}
+
+func expandProductVariantDraft(input map[string]interface{}) (commercetools.ProductVariantDraft, error) {
+	draft := commercetools.ProductVariantDraft{
+		SKU: input["sku"].(string),
+		Key: input["key"].(string),
+	}
+
+	attributes := input["attributes"].(map[string]interface{})
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value, err := expandProductAttributeValue(name, attributes[name].(string))
+		if err != nil {
+			return draft, err
+		}
+		draft.Attributes = append(draft.Attributes, commercetools.Attribute{
+			Name:  name,
+			Value: value,
+		})
+	}
+
+	prices, err := expandProductPriceDrafts(input["price"].([]interface{}))
+	if err != nil {
+		return draft, err
+	}
+	draft.Prices = prices
+
+	return draft, nil
+}
+
+func expandProductAttributeValue(name string, input string) (interface{}, error) {
+	var value interface{}
+	if err := json.Unmarshal([]byte(input), &value); err != nil {
+		return nil, fmt.Errorf("Value of attribute %s is not valid JSON: %s", name, err)
+	}
+	return value, nil
+}
+
+func expandProductPriceDrafts(input []interface{}) ([]commercetools.PriceDraft, error) {
+	result := []commercetools.PriceDraft{}
+	for _, raw := range input {
+		price := raw.(map[string]interface{})
+		draft := commercetools.PriceDraft{
+			Value: &commercetools.Money{
+				CurrencyCode: commercetools.CurrencyCode(price["currency_code"].(string)),
+				CentAmount:   price["cent_amount"].(int),
+			},
+			Country: commercetools.CountryCode(price["country"].(string)),
+		}
+
+		if val := price["customer_group_id"].(string); val != "" {
+			draft.CustomerGroup = &commercetools.CustomerGroupResourceIdentifier{ID: val}
+		}
+		if val := price["channel_id"].(string); val != "" {
+			draft.Channel = &commercetools.ChannelResourceIdentifier{ID: val}
+		}
+		if val := price["valid_from"].(string); val != "" {
+			validFrom, err := expandDate(val)
+			if err != nil {
+				return nil, err
+			}
+			draft.ValidFrom = &validFrom
+		}
+		if val := price["valid_until"].(string); val != "" {
+			validUntil, err := expandDate(val)
+			if err != nil {
+				return nil, err
+			}
+			draft.ValidUntil = &validUntil
+		}
+
+		result = append(result, draft)
+	}
+	return result, nil
+}
+
+// productStateVariants returns the variants in the state by sku, together with
+// the order of the additional variants. The API returns the variants in the
+// order they were added, which differs from the configuration after variants
+// are removed or reordered.
+func productStateVariants(d *schema.ResourceData) (map[string]map[string]interface{}, map[string]int) {
+	variants := make(map[string]map[string]interface{})
+	order := make(map[string]int)
+	for _, raw := range d.Get("master_variant").([]interface{}) {
+		if variant, ok := raw.(map[string]interface{}); ok {
+			variants[variant["sku"].(string)] = variant
+		}
+	}
+	for i, raw := range d.Get("variant").([]interface{}) {
+		if variant, ok := raw.(map[string]interface{}); ok {
+			variants[variant["sku"].(string)] = variant
+			order[variant["sku"].(string)] = i
+		}
+	}
+	return variants, order
+}
+
+func flattenProductVariant(variant *commercetools.ProductVariant, stateVariants map[string]map[string]interface{}) (map[string]interface{}, error) {
+	stateAttributes := map[string]interface{}{}
+	if stateVariant, ok := stateVariants[variant.SKU]; ok {
+		if val, ok := stateVariant["attributes"].(map[string]interface{}); ok {
+			stateAttributes = val
+		}
+	}
+
+	attributes := make(map[string]string, len(variant.Attributes))
+	for _, attribute := range variant.Attributes {
+		configured, _ := stateAttributes[attribute.Name].(string)
+		data, err := flattenProductAttributeValue(attribute.Value, configured)
+		if err != nil {
+			return nil, err
+		}
+		attributes[attribute.Name] = data
+	}
+
+	prices := make([]map[string]interface{}, 0, len(variant.Prices))
+	for _, price := range variant.Prices {
+		prices = append(prices, flattenProductPrice(price))
+	}
+
+	return map[string]interface{}{
+		"id":         variant.ID,
+		"sku":        variant.SKU,
+		"key":        variant.Key,
+		"attributes": attributes,
+		"price":      prices,
+	}, nil
+}
+
+// flattenProductAttributeValue returns the JSON of an attribute value in the
+// shape of the configured value. The configured string is kept as is when
+// both are the same value, so a different formatting doesn't cause a diff.
+func flattenProductAttributeValue(value interface{}, configured string) (string, error) {
+	var expected interface{}
+	if configured != "" {
+		if err := json.Unmarshal([]byte(configured), &expected); err != nil {
+			expected = nil
+		}
+	}
+
+	value = normalizeProductAttributeValue(value, expected)
+	if expected != nil && reflect.DeepEqual(value, expected) {
+		return configured, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// normalizeProductAttributeValue converts a value read from the API to the
+// shape of the configured value. Enum values are returned with their label,
+// while they are set with only the key, and money values are returned with
+// their type and fraction digits.
+func normalizeProductAttributeValue(value interface{}, expected interface{}) interface{} {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		_, hasKey := value["key"]
+		_, hasLabel := value["label"]
+		if hasKey && hasLabel {
+			switch expected := expected.(type) {
+			case string:
+				return value["key"]
+			case map[string]interface{}:
+				if _, ok := expected["label"]; !ok {
+					return map[string]interface{}{"key": value["key"]}
+				}
+			}
+			return value
+		}
+
+		_, hasCurrency := value["currencyCode"]
+		_, hasAmount := value["centAmount"]
+		if expected, ok := expected.(map[string]interface{}); ok && hasCurrency && hasAmount {
+			result := make(map[string]interface{}, len(value))
+			for name, val := range value {
+				if _, ok := expected[name]; ok || (name != "type" && name != "fractionDigits") {
+					result[name] = val
+				}
+			}
+			return result
+		}
+	case []interface{}:
+		// The items of a set are compared with the configured item at the
+		// same position, or the first one for items which were added
+		expected, ok := expected.([]interface{})
+		if !ok || len(expected) == 0 {
+			return value
+		}
+		result := make([]interface{}, len(value))
+		for i, item := range value {
+			if i < len(expected) {
+				result[i] = normalizeProductAttributeValue(item, expected[i])
+			} else {
+				result[i] = normalizeProductAttributeValue(item, expected[0])
+			}
+		}
+		return result
+	}
+	return value
+}
+
+func flattenProductPrice(price commercetools.Price) map[string]interface{} {
+	result := map[string]interface{}{
+		"id":                price.ID,
+		"country":           string(price.Country),
+		"customer_group_id": "",
+		"channel_id":        "",
+		"valid_from":        flattenDate(price.ValidFrom),
+		"valid_until":       flattenDate(price.ValidUntil),
+	}
+	if money := flattenTypedMoney(price.Value); money != nil {
+		result["currency_code"] = money["currency_code"]
+		result["cent_amount"] = money["cent_amount"]
+	}
+	if price.CustomerGroup != nil {
+		result["customer_group_id"] = price.CustomerGroup.ID
+	}
+	if price.Channel != nil {
+		result["channel_id"] = price.Channel.ID
+	}
+	return result
+}
@@ -0,0 +1,384 @@
+package commercetools
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+func resourceProductDiscount() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProductDiscountCreate,
+		Read:   resourceProductDiscountRead,
+		Update: resourceProductDiscountUpdate,
+		Delete: resourceProductDiscountDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     TypeLocalizedString,
+				Required: true,
+			},
+			"description": {
+				Type:     TypeLocalizedString,
+				Optional: true,
+			},
+			"value": {
+				Type:     schema.TypeList,
+				MaxItems: 1,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateProductDiscountValueType,
+						},
+						// Relative discount specific fields
+						"permyriad": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+						// Absolute discount specific fields
+						"money": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"currency_code": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: ValidateCurrencyCode,
+									},
+									"cent_amount": {
+										Type:     schema.TypeInt,
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"predicate": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"sort_order": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"is_active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"valid_from": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: diffSuppressDate,
+			},
+			"valid_until": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: diffSuppressDate,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func validateProductDiscountValueType(val interface{}, key string) (warns []string, errs []error) {
+	switch val {
+	case
+		"relative",
+		"absolute",
+		"external":
+		return
+	default:
+		errs = append(errs, fmt.Errorf("%q not a valid value for %q", val, key))
+	}
+	return
+}
+
+func resourceProductDiscountCreate(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+	var productDiscount *commercetools.ProductDiscount
+
+	name := commercetools.LocalizedString(
+		expandStringMap(d.Get("name").(map[string]interface{})))
+	description := commercetools.LocalizedString(
+		expandStringMap(d.Get("description").(map[string]interface{})))
+
+	value, err := resourceProductDiscountGetValue(d)
+	if err != nil {
+		return err
+	}
+
+	draft := &commercetools.ProductDiscountDraft{
+		Key:         d.Get("key").(string),
+		Name:        &name,
+		Description: &description,
+		Value:       value,
+		Predicate:   d.Get("predicate").(string),
+		SortOrder:   d.Get("sort_order").(string),
+		IsActive:    d.Get("is_active").(bool),
+	}
+
+	if val := d.Get("valid_from").(string); len(val) > 0 {
+		validFrom, err := expandDate(val)
+		if err != nil {
+			return err
+		}
+		draft.ValidFrom = &validFrom
+	}
+	if val := d.Get("valid_until").(string); len(val) > 0 {
+		validUntil, err := expandDate(val)
+		if err != nil {
+			return err
+		}
+		draft.ValidUntil = &validUntil
+	}
+
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+
+		productDiscount, err = client.ProductDiscountCreate(context.Background(), draft)
+		if err != nil {
+			return handleCommercetoolsError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(productDiscount.ID)
+	d.Set("version", productDiscount.Version)
+
+	return resourceProductDiscountRead(d, m)
+}
+
+func resourceProductDiscountRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Reading product discount from commercetools, with productDiscount id: %s", d.Id())
+
+	client := getClient(m)
+
+	productDiscount, err := client.ProductDiscountGetWithID(context.Background(), d.Id())
+
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			if ctErr.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	log.Print("[DEBUG] Found following product discount:")
+	log.Print(stringFormatObject(productDiscount))
+
+	d.Set("version", productDiscount.Version)
+	d.Set("key", productDiscount.Key)
+	if productDiscount.Name != nil {
+		d.Set("name", *productDiscount.Name)
+	}
+	if productDiscount.Description != nil {
+		d.Set("description", *productDiscount.Description)
+	}
+	if err := d.Set("value", flattenProductDiscountValue(productDiscount.Value)); err != nil {
+		return err
+	}
+	d.Set("predicate", productDiscount.Predicate)
+	d.Set("sort_order", productDiscount.SortOrder)
+	d.Set("is_active", productDiscount.IsActive)
+	d.Set("valid_from", flattenDate(productDiscount.ValidFrom))
+	d.Set("valid_until", flattenDate(productDiscount.ValidUntil))
+
+	return nil
+}
+
+func resourceProductDiscountUpdate(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+
+	input := &commercetools.ProductDiscountUpdateWithIDInput{
+		ID:      d.Id(),
+		Version: d.Get("version").(int),
+		Actions: []commercetools.ProductDiscountUpdateAction{},
+	}
+
+	if d.HasChange("key") {
+		newKey := d.Get("key").(string)
+		input.Actions = append(
+			input.Actions,
+			&commercetools.ProductDiscountSetKeyAction{Key: newKey})
+	}
+
+	if d.HasChange("name") {
+		newName := commercetools.LocalizedString(
+			expandStringMap(d.Get("name").(map[string]interface{})))
+		input.Actions = append(
+			input.Actions,
+			&commercetools.ProductDiscountChangeNameAction{Name: &newName})
+	}
+
+	if d.HasChange("description") {
+		newDescription := commercetools.LocalizedString(
+			expandStringMap(d.Get("description").(map[string]interface{})))
+		input.Actions = append(
+			input.Actions,
+			&commercetools.ProductDiscountSetDescriptionAction{Description: &newDescription})
+	}
+
+	if d.HasChange("value") {
+		value, err := resourceProductDiscountGetValue(d)
+		if err != nil {
+			return err
+		}
+		input.Actions = append(
+			input.Actions,
+			&commercetools.ProductDiscountChangeValueAction{Value: value})
+	}
+
+	if d.HasChange("predicate") {
+		newPredicate := d.Get("predicate").(string)
+		input.Actions = append(
+			input.Actions,
+			&commercetools.ProductDiscountChangePredicateAction{Predicate: newPredicate})
+	}
+
+	if d.HasChange("sort_order") {
+		newSortOrder := d.Get("sort_order").(string)
+		input.Actions = append(
+			input.Actions,
+			&commercetools.ProductDiscountChangeSortOrderAction{SortOrder: newSortOrder})
+	}
+
+	if d.HasChange("is_active") {
+		newIsActive := d.Get("is_active").(bool)
+		input.Actions = append(
+			input.Actions,
+			&commercetools.ProductDiscountChangeIsActiveAction{IsActive: newIsActive})
+	}
+
+	if d.HasChange("valid_from") {
+		if val := d.Get("valid_from").(string); len(val) > 0 {
+			newValidFrom, err := expandDate(val)
+			if err != nil {
+				return err
+			}
+			input.Actions = append(
+				input.Actions,
+				&commercetools.ProductDiscountSetValidFromAction{ValidFrom: &newValidFrom})
+		} else {
+			input.Actions = append(
+				input.Actions,
+				&commercetools.ProductDiscountSetValidFromAction{})
+		}
+	}
+
+	if d.HasChange("valid_until") {
+		if val := d.Get("valid_until").(string); len(val) > 0 {
+			newValidUntil, err := expandDate(val)
+			if err != nil {
+				return err
+			}
+			input.Actions = append(
+				input.Actions,
+				&commercetools.ProductDiscountSetValidUntilAction{ValidUntil: &newValidUntil})
+		} else {
+			input.Actions = append(
+				input.Actions,
+				&commercetools.ProductDiscountSetValidUntilAction{})
+		}
+	}
+
+	log.Printf(
+		"[DEBUG] Will perform update operation with the following actions:\n%s",
+		stringFormatActions(input.Actions))
+
+	_, err := client.ProductDiscountUpdateWithID(context.Background(), input)
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
+		}
+		return err
+	}
+
+	return resourceProductDiscountRead(d, m)
+}
+
+func resourceProductDiscountDelete(d *schema.ResourceData, m interface{}) error {
+	client := getClient(m)
+	version := d.Get("version").(int)
+	_, err := client.ProductDiscountDeleteWithID(context.Background(), d.Id(), version)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func resourceProductDiscountGetValue(d *schema.ResourceData) (commercetools.ProductDiscountValueDraft, error) {
+	value := d.Get("value").([]interface{})[0].(map[string]interface{})
+	switch value["type"].(string) {
+	case "relative":
+		return commercetools.ProductDiscountValueRelativeDraft{
+			Permyriad: value["permyriad"].(int),
+		}, nil
+	case "absolute":
+		return commercetools.ProductDiscountValueAbsoluteDraft{
+			Money: resourceCartDiscountGetMoney(value),
+		}, nil
+	case "external":
+		return commercetools.ProductDiscountValueExternalDraft{}, nil
+	default:
+		return nil, fmt.Errorf("Value type %s not implemented", value["type"])
+	}
+}
+
+func flattenProductDiscountValue(value commercetools.ProductDiscountValue) []map[string]interface{} {
+	switch typedValue := value.(type) {
+	case commercetools.ProductDiscountValueRelative:
+		return []map[string]interface{}{
+			{
+				"type":      "relative",
+				"permyriad": typedValue.Permyriad,
+			},
+		}
+	case commercetools.ProductDiscountValueAbsolute:
+		money := make([]map[string]interface{}, 0, len(typedValue.Money))
+		for _, item := range typedValue.Money {
+			if flattened := flattenTypedMoney(item); flattened != nil {
+				money = append(money, flattened)
+			}
+		}
+		return []map[string]interface{}{
+			{
+				"type":  "absolute",
+				"money": money,
+			},
+		}
+	case commercetools.ProductDiscountValueExternal:
+		return []map[string]interface{}{
+			{
+				"type": "external",
+			},
+		}
+	}
+	return []map[string]interface{}{}
+}
@@ -0,0 +1,147 @@
+package commercetools
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenProductDiscountValue(t *testing.T) {
+	result := flattenProductDiscountValue(commercetools.ProductDiscountValueAbsolute{
+		Money: []commercetools.TypedMoney{
+			commercetools.CentPrecisionMoney{
+				CurrencyCode: "EUR",
+				CentAmount:   500,
+			},
+		},
+	})
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"type": "absolute",
+			"money": []map[string]interface{}{
+				{
+					"currency_code": "EUR",
+					"cent_amount":   500,
+				},
+			},
+		},
+	}, result)
+
+	result = flattenProductDiscountValue(commercetools.ProductDiscountValueRelative{Permyriad: 1000})
+	assert.Equal(t, "relative", result[0]["type"])
+	assert.Equal(t, 1000, result[0]["permyriad"])
+}
+
+func TestAccProductDiscountCreate_basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckProductDiscountDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProductDiscountConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_product_discount.standard", "key", "standard",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product_discount.standard", "name.en", "Standard name",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product_discount.standard", "value.0.type", "relative",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product_discount.standard", "value.0.permyriad", "1000",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product_discount.standard", "sort_order", "0.9",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product_discount.standard", "valid_from", "2020-01-02T15:04:05Z",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product_discount.standard", "is_active", "true",
+					),
+				),
+			},
+			{
+				Config: testAccProductDiscountUpdate(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_product_discount.standard", "key", "standard_new",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product_discount.standard", "value.0.type", "absolute",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product_discount.standard", "value.0.money.#", "2",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product_discount.standard", "value.0.money.1.cent_amount", "4000",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product_discount.standard", "valid_from", "",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product_discount.standard", "is_active", "false",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccProductDiscountConfig() string {
+	return `
+	resource "commercetools_product_discount" "standard" {
+		key = "standard"
+		name = {
+			en = "Standard name"
+		}
+		description = {
+			en = "Standard description"
+		}
+		value {
+			type = "relative"
+			permyriad = 1000
+		}
+		predicate = "1=1"
+		sort_order = "0.9"
+		valid_from = "2020-01-02T15:04:05.000Z"
+	}`
+}
+
+func testAccProductDiscountUpdate() string {
+	return `
+	resource "commercetools_product_discount" "standard" {
+		key = "standard_new"
+		name = {
+			en = "Standard name"
+		}
+		description = {
+			en = "Standard description new"
+		}
+		value {
+			type = "absolute"
+			money {
+				currency_code = "USD"
+				cent_amount = 3000
+			}
+			money {
+				currency_code = "EUR"
+				cent_amount = 4000
+			}
+		}
+		predicate = "1=1"
+		sort_order = "0.8"
+		is_active = false
+	}`
+}
+
+func testAccCheckProductDiscountDestroy(s *terraform.State) error {
+	return nil
+}
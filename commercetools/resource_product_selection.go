@@ -0,0 +1,192 @@
+package commercetools
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+// Product selections are not available in the commercetools-go-sdk, so this
+// resource uses the rest client with the types defined at the bottom of this
+// file.
+func resourceProductSelection() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceProductSelectionCreate,
+		Read:   resourceProductSelectionRead,
+		Update: resourceProductSelectionUpdate,
+		Delete: resourceProductSelectionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"name": {
+				Type:     TypeLocalizedString,
+				Required: true,
+			},
+			"mode": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "Individual",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Individual",
+					"IndividualExclusion",
+				}, false),
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceProductSelectionCreate(d *schema.ResourceData, m interface{}) error {
+	client := getRestClient(m)
+	var selection *productSelection
+
+	name := commercetools.LocalizedString(
+		expandStringMap(d.Get("name").(map[string]interface{})))
+
+	draft := &productSelectionDraft{
+		Key:  d.Get("key").(string),
+		Name: &name,
+		Mode: d.Get("mode").(string),
+	}
+
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		selection = &productSelection{}
+		err := client.create(context.Background(), "product-selections", draft, selection)
+		if err != nil {
+			return handleCommercetoolsError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(selection.ID)
+	d.Set("version", selection.Version)
+
+	return resourceProductSelectionRead(d, m)
+}
+
+func resourceProductSelectionRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Reading product selection from commercetools, with product selection id: %s", d.Id())
+	client := getRestClient(m)
+
+	selection := &productSelection{}
+	err := client.get(context.Background(), fmt.Sprintf("product-selections/%s", d.Id()), nil, selection)
+
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			if ctErr.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	log.Print("[DEBUG] Found following product selection:")
+	log.Print(stringFormatObject(selection))
+
+	d.Set("version", selection.Version)
+	d.Set("key", selection.Key)
+	if selection.Name != nil {
+		d.Set("name", *selection.Name)
+	}
+	// The mode is not returned for product selections created before the mode
+	// was introduced, these are all individual selections.
+	if selection.Mode != "" {
+		d.Set("mode", selection.Mode)
+	} else {
+		d.Set("mode", "Individual")
+	}
+
+	return nil
+}
+
+func resourceProductSelectionUpdate(d *schema.ResourceData, m interface{}) error {
+	client := getRestClient(m)
+
+	actions := []interface{}{}
+
+	if d.HasChange("key") {
+		actions = append(actions, productSelectionSetKeyAction{
+			Action: "setKey",
+			Key:    d.Get("key").(string),
+		})
+	}
+
+	if d.HasChange("name") {
+		newName := commercetools.LocalizedString(
+			expandStringMap(d.Get("name").(map[string]interface{})))
+		actions = append(actions, productSelectionChangeNameAction{
+			Action: "changeName",
+			Name:   &newName,
+		})
+	}
+
+	log.Printf(
+		"[DEBUG] Will perform update operation with the following actions:\n%s",
+		stringFormatActions(actions))
+
+	err := client.update(
+		context.Background(), fmt.Sprintf("product-selections/%s", d.Id()),
+		d.Get("version").(int), actions, nil)
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
+		}
+		return err
+	}
+
+	return resourceProductSelectionRead(d, m)
+}
+
+func resourceProductSelectionDelete(d *schema.ResourceData, m interface{}) error {
+	client := getRestClient(m)
+	version := d.Get("version").(int)
+	err := client.delete(context.Background(), fmt.Sprintf("product-selections/%s", d.Id()), version, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type productSelection struct {
+	ID      string                         `json:"id"`
+	Version int                            `json:"version"`
+	Key     string                         `json:"key,omitempty"`
+	Name    *commercetools.LocalizedString `json:"name"`
+	Mode    string                         `json:"mode,omitempty"`
+}
+
+type productSelectionDraft struct {
+	Key  string                         `json:"key,omitempty"`
+	Name *commercetools.LocalizedString `json:"name"`
+	Mode string                         `json:"mode,omitempty"`
+}
+
+type productSelectionSetKeyAction struct {
+	Action string `json:"action"`
+	Key    string `json:"key,omitempty"`
+}
+
+type productSelectionChangeNameAction struct {
+	Action string                         `json:"action"`
+	Name   *commercetools.LocalizedString `json:"name"`
+}
@@ -0,0 +1,75 @@
+package commercetools
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccProductSelection_createAndAssign(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckProductSelectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProductSelectionConfig("Summer assortment", "true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_product_selection.summer", "key", "summer",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product_selection.summer", "name.en", "Summer assortment",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product_selection.summer", "mode", "Individual",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_store.assortment", "product_selection.#", "1",
+					),
+				),
+			},
+			{
+				Config: testAccProductSelectionConfig("Summer assortment 2021", "false"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_product_selection.summer", "name.en", "Summer assortment 2021",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_store.assortment", "product_selection.#", "1",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccProductSelectionConfig(name string, active string) string {
+	return fmt.Sprintf(`
+	resource "commercetools_product_selection" "summer" {
+		key = "summer"
+		name = {
+			en = "%s"
+		}
+	}
+
+	resource "commercetools_store" "assortment" {
+		key = "assortment-store"
+		name = {
+			en = "Assortment store"
+		}
+
+		product_selection {
+			product_selection_id = commercetools_product_selection.summer.id
+			active = %s
+		}
+	}
+	`, name, active)
+}
+
+func testAccCheckProductSelectionDestroy(s *terraform.State) error {
+	return nil
+}
@@ -0,0 +1,225 @@
+package commercetools
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandProductVariantDraft(t *testing.T) {
+	draft, err := expandProductVariantDraft(map[string]interface{}{
+		"sku": "gift-card-25",
+		"key": "gift-card-25",
+		"attributes": map[string]interface{}{
+			"size":  `"L"`,
+			"color": `{"en": "red"}`,
+		},
+		"price": []interface{}{
+			map[string]interface{}{
+				"currency_code":     "EUR",
+				"cent_amount":       2500,
+				"country":           "NL",
+				"customer_group_id": "",
+				"channel_id":        "",
+				"valid_from":        "",
+				"valid_until":       "",
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commercetools.ProductVariantDraft{
+		SKU: "gift-card-25",
+		Key: "gift-card-25",
+		Attributes: []commercetools.Attribute{
+			{Name: "color", Value: map[string]interface{}{"en": "red"}},
+			{Name: "size", Value: "L"},
+		},
+		Prices: []commercetools.PriceDraft{
+			{
+				Value: &commercetools.Money{
+					CurrencyCode: "EUR",
+					CentAmount:   2500,
+				},
+				Country: "NL",
+			},
+		},
+	}, draft)
+
+	_, err = expandProductVariantDraft(map[string]interface{}{
+		"sku":        "gift-card-25",
+		"key":        "",
+		"attributes": map[string]interface{}{"size": "L"},
+		"price":      []interface{}{},
+	})
+	assert.Error(t, err)
+}
+
+func TestFlattenProductAttributeValue(t *testing.T) {
+	testCases := []struct {
+		value      interface{}
+		configured string
+		expected   string
+	}{
+		{"L", `"L"`, `"L"`},
+		{float64(50), `50`, `50`},
+		{map[string]interface{}{"en": "red"}, `{ "en": "red" }`, `{ "en": "red" }`},
+		{map[string]interface{}{"key": "red", "label": "Red"}, `"red"`, `"red"`},
+		{map[string]interface{}{"key": "blue", "label": "Blue"}, `"red"`, `"blue"`},
+		{map[string]interface{}{"key": "red", "label": "Red"}, `{"key": "red"}`, `{"key": "red"}`},
+		{map[string]interface{}{"key": "red", "label": "Red"}, "", `{"key":"red","label":"Red"}`},
+		{
+			map[string]interface{}{"type": "centPrecision", "currencyCode": "EUR", "centAmount": float64(2500), "fractionDigits": float64(2)},
+			`{"currencyCode": "EUR", "centAmount": 2500}`,
+			`{"currencyCode": "EUR", "centAmount": 2500}`,
+		},
+		{
+			map[string]interface{}{"type": "centPrecision", "currencyCode": "EUR", "centAmount": float64(3000), "fractionDigits": float64(2)},
+			`{"currencyCode": "EUR", "centAmount": 2500}`,
+			`{"centAmount":3000,"currencyCode":"EUR"}`,
+		},
+		{
+			[]interface{}{
+				map[string]interface{}{"key": "red", "label": "Red"},
+				map[string]interface{}{"key": "blue", "label": "Blue"},
+			},
+			`["red"]`,
+			`["red","blue"]`,
+		},
+	}
+
+	for _, tc := range testCases {
+		result, err := flattenProductAttributeValue(tc.value, tc.configured)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, result)
+	}
+}
+
+func TestAccProductCreate_basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckProductDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProductConfig("Gift card", false, `
+					variant {
+						sku = "gift-card-50"
+						attributes = {
+							value = jsonencode(50)
+						}
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_product.gift_card", "name.en", "Gift card",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product.gift_card", "publish", "false",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product.gift_card", "master_variant.0.sku", "gift-card-25",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product.gift_card", "master_variant.0.id", "1",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product.gift_card", "master_variant.0.attributes.value", "25",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product.gift_card", "master_variant.0.price.0.cent_amount", "2500",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product.gift_card", "variant.#", "1",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product.gift_card", "variant.0.sku", "gift-card-50",
+					),
+				),
+			},
+			{
+				Config: testAccProductConfig("Gift card new", true, `
+					variant {
+						sku = "gift-card-100"
+						attributes = {
+							value = jsonencode(100)
+						}
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_product.gift_card", "name.en", "Gift card new",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product.gift_card", "publish", "true",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product.gift_card", "variant.#", "1",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product.gift_card", "variant.0.sku", "gift-card-100",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_product.gift_card", "variant.0.attributes.value", "100",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccProductConfig(name string, publish bool, variants string) string {
+	return fmt.Sprintf(`
+	resource "commercetools_product_type" "gift_card" {
+		key = "gift-card"
+		name = "Gift card"
+
+		attribute {
+			name = "value"
+			label = {
+				en = "Value"
+			}
+			type {
+				name = "number"
+			}
+			constraint = "CombinationUnique"
+		}
+	}
+
+	resource "commercetools_tax_category" "gift_card" {
+		key = "gift-card"
+		name = "Gift card"
+	}
+
+	resource "commercetools_product" "gift_card" {
+		key = "gift-card"
+		product_type_id = commercetools_product_type.gift_card.id
+		tax_category_id = commercetools_tax_category.gift_card.id
+		name = {
+			en = "%s"
+		}
+		slug = {
+			en = "gift-card"
+		}
+		publish = %t
+
+		master_variant {
+			sku = "gift-card-25"
+			attributes = {
+				value = jsonencode(25)
+			}
+			price {
+				currency_code = "EUR"
+				cent_amount = 2500
+			}
+		}
+		%s
+	}
+	`, name, publish, variants)
+}
+
+func testAccCheckProductDestroy(s *terraform.State) error {
+	return nil
+}
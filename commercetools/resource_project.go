@@ -1,9 +1,15 @@
 package commercetools
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/labd/commercetools-go-sdk/commercetools"
-	"log"
 )
 
 func resourceProjectSettings() *schema.Resource {
@@ -49,6 +55,56 @@ func resourceProjectSettings() *schema.Resource {
 							Type:     schema.TypeBool,
 							Required: true,
 						},
+						"delete_days_after_creation": {
+							Type:     schema.TypeInt,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"carts": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"country_tax_rate_fallback_enabled": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"delete_days_after_last_modification": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"shipping_rate_input_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"CartValue",
+					"CartClassification",
+					"CartScore",
+				}, false),
+			},
+			"shipping_rate_cart_classification_value": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"label": {
+							Type:     TypeLocalizedString,
+							Required: true,
+						},
 					},
 				},
 			},
@@ -68,6 +124,30 @@ func resourceProjectSettings() *schema.Resource {
 					},
 				},
 			},
+			"reset_on_destroy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"currencies": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"countries": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"languages": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
 			"version": {
 				Type:     schema.TypeInt,
 				Computed: true,
@@ -105,6 +185,7 @@ func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	err = projectUpdate(d, client, project.Version)
+	getReadCache(m).invalidate(projectCacheKey)
 	if err != nil {
 		return err
 	}
@@ -113,9 +194,13 @@ func resourceProjectCreate(d *schema.ResourceData, m interface{}) error {
 
 func resourceProjectRead(d *schema.ResourceData, m interface{}) error {
 	log.Print("[DEBUG] Reading projects from commercetools")
-	client := getClient(m)
+	client := getRestClient(m)
 
-	project, err := client.ProjectGet()
+	// The carts configuration is only partially available in the
+	// commercetools-go-sdk, so the project is read as raw JSON and decoded
+	// into the sdk type and the additional fields.
+	var raw json.RawMessage
+	err := client.get(context.Background(), "", nil, &raw)
 
 	if err != nil {
 		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
@@ -126,6 +211,16 @@ func resourceProjectRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	project := &commercetools.Project{}
+	if err := json.Unmarshal(raw, project); err != nil {
+		return err
+	}
+	getReadCache(m).store(projectCacheKey, project, project.Version)
+	extra := &projectExtraFields{}
+	if err := json.Unmarshal(raw, extra); err != nil {
+		return err
+	}
+
 	log.Print("[DEBUG] Found the following project:")
 	log.Print(stringFormatObject(project))
 
@@ -140,9 +235,25 @@ func resourceProjectRead(d *schema.ResourceData, m interface{}) error {
 	// d.Set("trialUntil", project.TrialUntil)
 	log.Print("[DEBUG] Logging messages enabled")
 	log.Print(stringFormatObject(project.Messages))
-	d.Set("messages", project.Messages)
+	// The messages are only set when they are managed, the map has no
+	// computed values to fall back on.
+	if current, ok := d.GetOk("messages"); ok && project.Messages != nil {
+		d.Set("messages", flattenProjectMessages(project.Messages, current.(map[string]interface{})))
+	}
 	log.Print(stringFormatObject(d))
-	// d.Set("shippingRateInputType", project.ShippingRateInputType)
+
+	carts := map[string]interface{}{
+		"country_tax_rate_fallback_enabled":   false,
+		"delete_days_after_last_modification": extra.Carts.DeleteDaysAfterLastModification,
+	}
+	if project.Carts != nil {
+		carts["country_tax_rate_fallback_enabled"] = project.Carts.CountryTaxRateFallbackEnabled
+	}
+	d.Set("carts", []interface{}{carts})
+
+	inputType, classificationValues := flattenProjectShippingRateInputType(project.ShippingRateInputType)
+	d.Set("shipping_rate_input_type", inputType)
+	d.Set("shipping_rate_cart_classification_value", classificationValues)
 
 	return nil
 }
@@ -151,17 +262,119 @@ func resourceProjectUpdate(d *schema.ResourceData, m interface{}) error {
 	client := getClient(m)
 	version := d.Get("version").(int)
 	err := projectUpdate(d, client, version)
+	getReadCache(m).invalidate(projectCacheKey)
 	if err != nil {
 		return err
 	}
 	return resourceProjectRead(d, m)
 }
 
+// projectCacheKey is the key of the project in the read cache
+const projectCacheKey = "project"
+
+// readProject returns the project from the read cache of the provider
+func readProject(m interface{}) (*commercetools.Project, error) {
+	client := getRestClient(m)
+	value, err := getReadCache(m).get(projectCacheKey, func() (interface{}, int, error) {
+		project := &commercetools.Project{}
+		err := client.get(context.Background(), "", nil, project)
+		if err != nil {
+			return nil, 0, err
+		}
+		return project, project.Version, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*commercetools.Project), nil
+}
+
+// resourceProjectDelete can not delete the project itself. When
+// reset_on_destroy is set the settings are reset to a known baseline, so the
+// project can be reused, otherwise the project is only removed from the state.
 func resourceProjectDelete(d *schema.ResourceData, m interface{}) error {
+	actions := projectResetActions(d)
+	if len(actions) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	client := getClient(m)
+	project, err := client.ProjectGet()
+	if err != nil {
+		return err
+	}
+
+	input := &commercetools.ProjectUpdateInput{
+		Version: project.Version,
+		Actions: actions,
+	}
+
+	log.Printf(
+		"[DEBUG] Will reset the project with the following actions:\n%s",
+		stringFormatActions(input.Actions))
+
+	_, err = client.ProjectUpdate(input)
+	getReadCache(m).invalidate(projectCacheKey)
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
+		}
+		return err
+	}
+
 	d.SetId("")
 	return nil
 }
 
+// projectResetActions returns the actions to reset the project when
+// reset_on_destroy is set. Messages are disabled and the external OAuth
+// configuration is removed, the currencies, countries and languages are only
+// changed when a baseline is configured for them.
+func projectResetActions(d *schema.ResourceData) []commercetools.ProjectUpdateAction {
+	items := d.Get("reset_on_destroy").([]interface{})
+	if len(items) == 0 {
+		return nil
+	}
+
+	actions := []commercetools.ProjectUpdateAction{
+		&commercetools.ProjectChangeMessagesEnabledAction{MessagesEnabled: false},
+		&commercetools.ProjectSetExternalOAuthAction{ExternalOAuth: nil},
+	}
+
+	// An empty reset_on_destroy block is read as nil
+	baseline, ok := items[0].(map[string]interface{})
+	if !ok {
+		return actions
+	}
+
+	if currencies := expandStringArray(baseline["currencies"].([]interface{})); len(currencies) > 0 {
+		newCurrencies := []commercetools.CurrencyCode{}
+		for _, item := range currencies {
+			newCurrencies = append(newCurrencies, commercetools.CurrencyCode(item))
+		}
+		actions = append(actions, &commercetools.ProjectChangeCurrenciesAction{Currencies: newCurrencies})
+	}
+
+	if countries := expandStringArray(baseline["countries"].([]interface{})); len(countries) > 0 {
+		newCountries := []commercetools.CountryCode{}
+		for _, item := range countries {
+			newCountries = append(newCountries, commercetools.CountryCode(item))
+		}
+		actions = append(actions, &commercetools.ProjectChangeCountriesAction{Countries: newCountries})
+	}
+
+	if languages := expandStringArray(baseline["languages"].([]interface{})); len(languages) > 0 {
+		newLanguages := []commercetools.Locale{}
+		for _, item := range languages {
+			newLanguages = append(newLanguages, commercetools.Locale(item))
+		}
+		actions = append(actions, &commercetools.ProjectChangeLanguagesAction{Languages: newLanguages})
+	}
+
+	return actions
+}
+
 func projectUpdate(d *schema.ResourceData, client *commercetools.Client, version int) error {
 	input := &commercetools.ProjectUpdateInput{
 		Version: version,
@@ -206,15 +419,53 @@ func projectUpdate(d *schema.ResourceData, client *commercetools.Client, version
 
 	if d.HasChange("messages") {
 		messages := d.Get("messages").(map[string]interface{})
-		// ¯\_(ツ)_/¯
-		enabled := false
-		if messages["enabled"] == "1" {
-			enabled = true
+		// The values of the messages map are strings, both "1" and "true"
+		// are accepted for enabled
+		enabled, _ := strconv.ParseBool(fmt.Sprint(messages["enabled"]))
+
+		if val, ok := messages["delete_days_after_creation"]; ok {
+			deleteDays, err := strconv.Atoi(fmt.Sprint(val))
+			if err != nil {
+				return fmt.Errorf("invalid value for messages.delete_days_after_creation: %s", val)
+			}
+			input.Actions = append(
+				input.Actions,
+				&commercetools.ProjectChangeMessagesConfigurationAction{
+					MessagesConfiguration: &commercetools.MessageConfigurationDraft{
+						Enabled:                 enabled,
+						DeleteDaysAfterCreation: float64(deleteDays),
+					},
+				})
+		} else {
+			input.Actions = append(
+				input.Actions,
+				&commercetools.ProjectChangeMessagesEnabledAction{MessagesEnabled: enabled})
 		}
+	}
 
+	if d.HasChange("carts") {
+		carts := projectCartsConfiguration{}
+		if items := d.Get("carts").([]interface{}); len(items) > 0 && items[0] != nil {
+			item := items[0].(map[string]interface{})
+			carts.CountryTaxRateFallbackEnabled = item["country_tax_rate_fallback_enabled"].(bool)
+			carts.DeleteDaysAfterLastModification = item["delete_days_after_last_modification"].(int)
+		}
+		input.Actions = append(
+			input.Actions,
+			projectChangeCartsConfigurationAction{
+				Action:             "changeCartsConfiguration",
+				CartsConfiguration: carts,
+			})
+	}
+
+	if d.HasChange("shipping_rate_input_type") || d.HasChange("shipping_rate_cart_classification_value") {
+		inputType, err := expandProjectShippingRateInputType(d)
+		if err != nil {
+			return err
+		}
 		input.Actions = append(
 			input.Actions,
-			&commercetools.ProjectChangeMessagesEnabledAction{MessagesEnabled: enabled})
+			&commercetools.ProjectSetShippingRateInputTypeAction{ShippingRateInputType: inputType})
 	}
 
 	if d.HasChange("external_oauth") {
@@ -236,6 +487,72 @@ func projectUpdate(d *schema.ResourceData, client *commercetools.Client, version
 	return err
 }
 
+func expandProjectShippingRateInputType(d *schema.ResourceData) (commercetools.ShippingRateInputType, error) {
+	classificationValues := d.Get("shipping_rate_cart_classification_value").([]interface{})
+
+	switch d.Get("shipping_rate_input_type").(string) {
+	case "CartValue":
+		return commercetools.CartValueType{}, nil
+	case "CartScore":
+		return commercetools.CartScoreType{}, nil
+	case "CartClassification":
+		if len(classificationValues) == 0 {
+			return nil, fmt.Errorf(
+				"shipping_rate_cart_classification_value is required for shipping rate input type CartClassification")
+		}
+		values := make([]commercetools.CustomFieldLocalizedEnumValue, len(classificationValues))
+		for i, raw := range classificationValues {
+			value := raw.(map[string]interface{})
+			label := commercetools.LocalizedString(
+				expandStringMap(value["label"].(map[string]interface{})))
+			values[i] = commercetools.CustomFieldLocalizedEnumValue{
+				Key:   value["key"].(string),
+				Label: &label,
+			}
+		}
+		return commercetools.CartClassificationType{Values: values}, nil
+	}
+
+	if len(classificationValues) > 0 {
+		return nil, fmt.Errorf(
+			"shipping_rate_cart_classification_value can only be used with shipping rate input type CartClassification")
+	}
+	return nil, nil
+}
+
+func flattenProjectShippingRateInputType(input commercetools.ShippingRateInputType) (string, []map[string]interface{}) {
+	switch t := input.(type) {
+	case commercetools.CartValueType:
+		return "CartValue", nil
+	case commercetools.CartScoreType:
+		return "CartScore", nil
+	case commercetools.CartClassificationType:
+		values := make([]map[string]interface{}, len(t.Values))
+		for i, value := range t.Values {
+			values[i] = map[string]interface{}{
+				"key": value.Key,
+			}
+			if value.Label != nil {
+				values[i]["label"] = *value.Label
+			}
+		}
+		return "CartClassification", values
+	}
+	return "", nil
+}
+
+// flattenProjectMessages returns the messages configuration as strings, the
+// delete days are only included when they are part of the current state.
+func flattenProjectMessages(messages *commercetools.MessageConfiguration, current map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{
+		"enabled": strconv.FormatBool(messages.Enabled),
+	}
+	if _, ok := current["delete_days_after_creation"]; ok {
+		result["delete_days_after_creation"] = strconv.Itoa(int(messages.DeleteDaysAfterCreation))
+	}
+	return result
+}
+
 func getStringSlice(d *schema.ResourceData, field string) []string {
 	input := d.Get(field).([]interface{})
 	var currencyObjects []string
@@ -245,3 +562,17 @@ func getStringSlice(d *schema.ResourceData, field string) []string {
 
 	return currencyObjects
 }
+
+type projectExtraFields struct {
+	Carts projectCartsConfiguration `json:"carts"`
+}
+
+type projectCartsConfiguration struct {
+	CountryTaxRateFallbackEnabled   bool `json:"countryTaxRateFallbackEnabled"`
+	DeleteDaysAfterLastModification int  `json:"deleteDaysAfterLastModification,omitempty"`
+}
+
+type projectChangeCartsConfigurationAction struct {
+	Action             string                    `json:"action"`
+	CartsConfiguration projectCartsConfiguration `json:"cartsConfiguration"`
+}
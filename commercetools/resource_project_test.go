@@ -4,9 +4,74 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestExpandProjectShippingRateInputType(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceProjectSettings().Schema, map[string]interface{}{
+		"shipping_rate_input_type": "CartClassification",
+		"shipping_rate_cart_classification_value": []interface{}{
+			map[string]interface{}{
+				"key": "Small",
+				"label": map[string]interface{}{
+					"en": "Small",
+					"nl": "Klein",
+				},
+			},
+		},
+	})
+
+	inputType, err := expandProjectShippingRateInputType(d)
+	assert.NoError(t, err)
+	assert.Equal(t, commercetools.CartClassificationType{
+		Values: []commercetools.CustomFieldLocalizedEnumValue{
+			{
+				Key:   "Small",
+				Label: &commercetools.LocalizedString{"en": "Small", "nl": "Klein"},
+			},
+		},
+	}, inputType)
+
+	name, values := flattenProjectShippingRateInputType(inputType)
+	assert.Equal(t, "CartClassification", name)
+	assert.Equal(t, "Small", values[0]["key"])
+
+	d = schema.TestResourceDataRaw(t, resourceProjectSettings().Schema, map[string]interface{}{
+		"shipping_rate_input_type": "CartClassification",
+	})
+	_, err = expandProjectShippingRateInputType(d)
+	assert.Error(t, err)
+}
+
+func TestProjectResetActions(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceProjectSettings().Schema, map[string]interface{}{
+		"name": "Test this thing",
+	})
+	assert.Empty(t, projectResetActions(d))
+
+	d = schema.TestResourceDataRaw(t, resourceProjectSettings().Schema, map[string]interface{}{
+		"reset_on_destroy": []interface{}{
+			map[string]interface{}{
+				"currencies": []interface{}{"EUR"},
+				"languages":  []interface{}{"en", "nl"},
+			},
+		},
+	})
+	assert.Equal(t, []commercetools.ProjectUpdateAction{
+		&commercetools.ProjectChangeMessagesEnabledAction{MessagesEnabled: false},
+		&commercetools.ProjectSetExternalOAuthAction{ExternalOAuth: nil},
+		&commercetools.ProjectChangeCurrenciesAction{
+			Currencies: []commercetools.CurrencyCode{"EUR"},
+		},
+		&commercetools.ProjectChangeLanguagesAction{
+			Languages: []commercetools.Locale{"en", "nl"},
+		},
+	}, projectResetActions(d))
+}
+
 func TestAccProjectCreate_basic(t *testing.T) {
 
 	resource.Test(t, resource.TestCase{
@@ -96,6 +161,40 @@ func TestAccProjectCreate_basic(t *testing.T) {
 	})
 }
 
+func TestAccProjectCreate_shippingAndCarts(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectConfigShippingAndCarts(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_project_settings.acctest_project_settings", "shipping_rate_input_type", "CartClassification",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_project_settings.acctest_project_settings", "shipping_rate_cart_classification_value.#", "2",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_project_settings.acctest_project_settings", "shipping_rate_cart_classification_value.1.label.en", "Heavy",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_project_settings.acctest_project_settings", "carts.0.country_tax_rate_fallback_enabled", "true",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_project_settings.acctest_project_settings", "carts.0.delete_days_after_last_modification", "30",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_project_settings.acctest_project_settings", "messages.delete_days_after_creation", "10",
+					),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckProjectDestroy(s *terraform.State) error {
 	return nil
 }
@@ -146,3 +245,36 @@ func testAccProjectConfigDeleteOAuth() string {
 			}
 		}`
 }
+
+func testAccProjectConfigShippingAndCarts() string {
+	return `
+		resource "commercetools_project_settings" "acctest_project_settings" {
+			name       = "Test this thing new"
+			countries  = ["NL", "DE", "US", "GB"]
+			currencies = ["EUR", "USD", "GBP"]
+			languages  = ["nl", "de", "en", "en-US", "fr"]
+			messages = {
+			  enabled                    = true
+			  delete_days_after_creation = 10
+			}
+
+			carts {
+				country_tax_rate_fallback_enabled   = true
+				delete_days_after_last_modification = 30
+			}
+
+			shipping_rate_input_type = "CartClassification"
+			shipping_rate_cart_classification_value {
+				key = "Light"
+				label = {
+					en = "Light"
+				}
+			}
+			shipping_rate_cart_classification_value {
+				key = "Heavy"
+				label = {
+					en = "Heavy"
+				}
+			}
+		}`
+}
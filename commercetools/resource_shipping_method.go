@@ -2,7 +2,12 @@ package commercetools
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"log"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -28,10 +33,18 @@ func resourceShippingMethod() *schema.Resource {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"localized_name": {
+				Type:     TypeLocalizedString,
+				Optional: true,
+			},
 			"description": {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"localized_description": {
+				Type:     TypeLocalizedString,
+				Optional: true,
+			},
 			"is_default": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -48,12 +61,70 @@ func resourceShippingMethod() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"zone_rate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"shipping_zone_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"shipping_rate": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"price": {
+										Type:     schema.TypeList,
+										Required: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: shippingMethodMoneySchema(),
+										},
+									},
+									"free_above": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: shippingMethodMoneySchema(),
+										},
+									},
+									"shipping_rate_price_tier": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Elem: &schema.Resource{
+											Schema: shippingRatePriceTierSchema(),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func shippingMethodMoneySchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"currency_code": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: ValidateCurrencyCode,
+		},
+		"cent_amount": {
+			Type:     schema.TypeInt,
+			Required: true,
 		},
 	}
 }
 
 func resourceShippingMethodCreate(d *schema.ResourceData, m interface{}) error {
-	client := getClient(m)
+	client := getRestClient(m)
 	var shippingMethod *commercetools.ShippingMethod
 	taxCategory := commercetools.TaxCategoryResourceIdentifier{}
 	if taxCategoryID, ok := d.GetOk("tax_category_id"); ok {
@@ -69,10 +140,34 @@ func resourceShippingMethodCreate(d *schema.ResourceData, m interface{}) error {
 		Predicate:   d.Get("predicate").(string),
 	}
 
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
+	if val, ok := d.GetOk("localized_description"); ok {
+		localizedDescription := commercetools.LocalizedString(
+			expandStringMap(val.(map[string]interface{})))
+		draft.LocalizedDescription = &localizedDescription
+	}
+
+	zoneRates, err := expandShippingMethodZoneRates(d.Get("zone_rate").([]interface{}))
+	if err != nil {
+		return err
+	}
+	for _, zoneRate := range zoneRates {
+		draft.ZoneRates = append(draft.ZoneRates, zoneRate.draft())
+	}
 
-		shippingMethod, err = client.ShippingMethodCreate(context.Background(), draft)
+	// The localized name is not part of the ShippingMethodDraft of the sdk, so
+	// the draft is extended with it and send with the rest client.
+	input := &shippingMethodDraft{
+		ShippingMethodDraft: draft,
+	}
+	if val, ok := d.GetOk("localized_name"); ok {
+		localizedName := commercetools.LocalizedString(
+			expandStringMap(val.(map[string]interface{})))
+		input.LocalizedName = &localizedName
+	}
+
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
+		shippingMethod = &commercetools.ShippingMethod{}
+		err := client.create(context.Background(), "shipping-methods", input, shippingMethod)
 		if err != nil {
 			return handleCommercetoolsError(err)
 		}
@@ -96,9 +191,12 @@ func resourceShippingMethodCreate(d *schema.ResourceData, m interface{}) error {
 func resourceShippingMethodRead(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] Reading shipping method from commercetools, with shippingMethod id: %s", d.Id())
 
-	client := getClient(m)
+	client := getRestClient(m)
 
-	shippingMethod, err := client.ShippingMethodGetWithID(context.Background(), d.Id())
+	// The shipping method is read as raw json since the sdk doesn't support
+	// the localized name.
+	var data json.RawMessage
+	err := client.get(context.Background(), fmt.Sprintf("shipping-methods/%s", d.Id()), nil, &data)
 
 	if err != nil {
 		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
@@ -110,20 +208,47 @@ func resourceShippingMethodRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	var shippingMethod *commercetools.ShippingMethod
+	if err := json.Unmarshal(data, &shippingMethod); err != nil {
+		return err
+	}
+	extraFields := shippingMethodExtraFields{}
+	if err := json.Unmarshal(data, &extraFields); err != nil {
+		return err
+	}
+
 	if shippingMethod == nil {
 		log.Print("[DEBUG] No shipping method found")
 		d.SetId("")
 	} else {
 		log.Print("[DEBUG] Found following shipping method:")
 		log.Print(stringFormatObject(shippingMethod))
+		getReadCache(m).store(shippingMethodCacheKey(shippingMethod.ID), shippingMethod, shippingMethod.Version)
 
 		d.Set("version", shippingMethod.Version)
 		d.Set("key", shippingMethod.Key)
 		d.Set("name", shippingMethod.Name)
+		if extraFields.LocalizedName != nil {
+			d.Set("localized_name", *extraFields.LocalizedName)
+		} else {
+			d.Set("localized_name", nil)
+		}
 		d.Set("description", shippingMethod.Description)
+		if shippingMethod.LocalizedDescription != nil {
+			d.Set("localized_description", *shippingMethod.LocalizedDescription)
+		} else {
+			d.Set("localized_description", nil)
+		}
 		d.Set("is_default", shippingMethod.IsDefault)
 		d.Set("tax_category_id", shippingMethod.TaxCategory.ID)
 		d.Set("predicate", shippingMethod.Predicate)
+
+		// The zone rates are only set when they are managed by this resource,
+		// otherwise they are managed by commercetools_shipping_zone_rate
+		// resources.
+		if state := d.Get("zone_rate").([]interface{}); len(state) > 0 {
+			d.Set("zone_rate", flattenShippingMethodZoneRates(shippingMethod.ZoneRates, state))
+		}
 	}
 
 	return nil
@@ -166,6 +291,28 @@ func resourceShippingMethodUpdate(d *schema.ResourceData, m interface{}) error {
 			&commercetools.ShippingMethodSetDescriptionAction{Description: newDescription})
 	}
 
+	if d.HasChange("localized_name") {
+		action := shippingMethodSetLocalizedNameAction{Action: "setLocalizedName"}
+		if val, ok := d.GetOk("localized_name"); ok {
+			localizedName := commercetools.LocalizedString(
+				expandStringMap(val.(map[string]interface{})))
+			action.LocalizedName = &localizedName
+		}
+		input.Actions = append(input.Actions, action)
+	}
+
+	if d.HasChange("localized_description") {
+		// The ShippingMethodSetLocalizedDescriptionAction of the sdk has a
+		// plain string as value, so a local type is used instead.
+		action := shippingMethodSetLocalizedDescriptionAction{Action: "setLocalizedDescription"}
+		if val, ok := d.GetOk("localized_description"); ok {
+			localizedDescription := commercetools.LocalizedString(
+				expandStringMap(val.(map[string]interface{})))
+			action.LocalizedDescription = &localizedDescription
+		}
+		input.Actions = append(input.Actions, action)
+	}
+
 	if d.HasChange("is_default") {
 		newIsDefault := d.Get("is_default").(bool)
 		input.Actions = append(
@@ -187,11 +334,21 @@ func resourceShippingMethodUpdate(d *schema.ResourceData, m interface{}) error {
 			&commercetools.ShippingMethodSetPredicateAction{Predicate: newPredicate})
 	}
 
+	if d.HasChange("zone_rate") {
+		old, new := d.GetChange("zone_rate")
+		actions, err := resourceShippingMethodZoneRateActions(shippingMethod, old.([]interface{}), new.([]interface{}))
+		if err != nil {
+			return err
+		}
+		input.Actions = append(input.Actions, actions...)
+	}
+
 	log.Printf(
 		"[DEBUG] Will perform update operation with the following actions:\n%s",
 		stringFormatActions(input.Actions))
 
 	_, err = client.ShippingMethodUpdateWithID(context.Background(), input)
+	getReadCache(m).invalidate(shippingMethodCacheKey(d.Id()))
 	if err != nil {
 		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
 			log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
@@ -214,9 +371,322 @@ func resourceShippingMethodDelete(d *schema.ResourceData, m interface{}) error {
 	}
 
 	_, err = client.ShippingMethodDeleteWithID(context.Background(), d.Id(), shippingMethod.Version)
+	getReadCache(m).invalidate(shippingMethodCacheKey(d.Id()))
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// shippingMethodZoneRate contains the shipping rates of a zone, by currency
+type shippingMethodZoneRate struct {
+	zoneID string
+	rates  map[string]commercetools.ShippingRateDraft
+}
+
+func (z shippingMethodZoneRate) currencies() []string {
+	result := make([]string, 0, len(z.rates))
+	for currency := range z.rates {
+		result = append(result, currency)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func (z shippingMethodZoneRate) draft() commercetools.ZoneRateDraft {
+	draft := commercetools.ZoneRateDraft{
+		Zone:          &commercetools.ZoneResourceIdentifier{ID: z.zoneID},
+		ShippingRates: []commercetools.ShippingRateDraft{},
+	}
+	for _, currency := range z.currencies() {
+		draft.ShippingRates = append(draft.ShippingRates, z.rates[currency])
+	}
+	return draft
+}
+
+func expandShippingMethodZoneRates(input []interface{}) ([]shippingMethodZoneRate, error) {
+	result := []shippingMethodZoneRate{}
+	seen := make(map[string]bool)
+	for _, raw := range input {
+		item := raw.(map[string]interface{})
+		zoneRate := shippingMethodZoneRate{
+			zoneID: item["shipping_zone_id"].(string),
+			rates:  make(map[string]commercetools.ShippingRateDraft),
+		}
+		if seen[zoneRate.zoneID] {
+			return nil, fmt.Errorf("zone %s is used in multiple zone_rate blocks", zoneRate.zoneID)
+		}
+		seen[zoneRate.zoneID] = true
+
+		for _, rawRate := range item["shipping_rate"].([]interface{}) {
+			rate, err := expandShippingMethodShippingRate(rawRate.(map[string]interface{}))
+			if err != nil {
+				return nil, err
+			}
+			currency := string(rate.Price.CurrencyCode)
+			if _, ok := zoneRate.rates[currency]; ok {
+				return nil, fmt.Errorf(
+					"zone %s has multiple shipping rates for currency %s", zoneRate.zoneID, currency)
+			}
+			zoneRate.rates[currency] = rate
+		}
+		result = append(result, zoneRate)
+	}
+	return result, nil
+}
+
+func expandShippingMethodShippingRate(input map[string]interface{}) (commercetools.ShippingRateDraft, error) {
+	price := input["price"].([]interface{})[0].(map[string]interface{})
+	currencyCode := commercetools.CurrencyCode(price["currency_code"].(string))
+
+	draft := commercetools.ShippingRateDraft{
+		Price: &commercetools.Money{
+			CurrencyCode: currencyCode,
+			CentAmount:   price["cent_amount"].(int),
+		},
+	}
+
+	if freeAbove := input["free_above"].([]interface{}); len(freeAbove) > 0 {
+		freeAboveMap := freeAbove[0].(map[string]interface{})
+		if freeAboveMap["currency_code"].(string) != string(currencyCode) {
+			return draft, fmt.Errorf(
+				"the currency of free_above has to match the currency of the price (%s)", currencyCode)
+		}
+		draft.FreeAbove = &commercetools.Money{
+			CurrencyCode: currencyCode,
+			CentAmount:   freeAboveMap["cent_amount"].(int),
+		}
+	}
+
+	tiers, err := expandShippingRatePriceTiers(
+		input["shipping_rate_price_tier"].([]interface{}), currencyCode)
+	if err != nil {
+		return draft, err
+	}
+	draft.Tiers = tiers
+	return draft, nil
+}
+
+// resourceShippingMethodZoneRateActions compares the old and new zone rates
+// and returns the actions to update them. A changed shipping rate is
+// replaced by removing the old rate and adding the new one. The update fails
+// when the shipping method has zones or rates which are not in the old zone
+// rates.
+func resourceShippingMethodZoneRateActions(shippingMethod *commercetools.ShippingMethod, old []interface{}, new []interface{}) ([]commercetools.ShippingMethodUpdateAction, error) {
+	oldZoneRates, err := expandShippingMethodZoneRates(old)
+	if err != nil {
+		return nil, err
+	}
+	newZoneRates, err := expandShippingMethodZoneRates(new)
+	if err != nil {
+		return nil, err
+	}
+
+	oldLookup := make(map[string]shippingMethodZoneRate)
+	for _, zoneRate := range oldZoneRates {
+		oldLookup[zoneRate.zoneID] = zoneRate
+	}
+
+	// Zones and rates which are not in the old zone_rate blocks are managed
+	// by commercetools_shipping_zone_rate resources, they would be removed
+	// by the update
+	unmanaged := []string{}
+	for _, zoneRate := range shippingMethod.ZoneRates {
+		if zoneRate.Zone == nil {
+			continue
+		}
+		oldZoneRate, ok := oldLookup[zoneRate.Zone.ID]
+		if !ok {
+			unmanaged = append(unmanaged, zoneRate.Zone.ID)
+			continue
+		}
+		for _, shippingRate := range zoneRate.ShippingRates {
+			price := flattenTypedMoney(shippingRate.Price)
+			if price == nil {
+				continue
+			}
+			currency := price["currency_code"].(string)
+			if _, ok := oldZoneRate.rates[currency]; !ok {
+				unmanaged = append(unmanaged, fmt.Sprintf("%s/%s", zoneRate.Zone.ID, currency))
+			}
+		}
+	}
+	if len(unmanaged) > 0 {
+		sort.Strings(unmanaged)
+		return nil, fmt.Errorf(
+			"shipping method %s has zone rates which are not managed by its zone_rate blocks: %s, "+
+				"zone_rate blocks can not be combined with commercetools_shipping_zone_rate resources",
+			shippingMethod.ID, strings.Join(unmanaged, ", "))
+	}
+	newLookup := make(map[string]shippingMethodZoneRate)
+	for _, zoneRate := range newZoneRates {
+		newLookup[zoneRate.zoneID] = zoneRate
+	}
+
+	actions := []commercetools.ShippingMethodUpdateAction{}
+	removeRate := func(zoneID string, rate commercetools.ShippingRateDraft) {
+		actions = append(actions, &commercetools.ShippingMethodRemoveShippingRateAction{
+			Zone:         &commercetools.ZoneResourceIdentifier{ID: zoneID},
+			ShippingRate: &rate,
+		})
+	}
+	addRate := func(zoneID string, rate commercetools.ShippingRateDraft) {
+		actions = append(actions, &commercetools.ShippingMethodAddShippingRateAction{
+			Zone:         &commercetools.ZoneResourceIdentifier{ID: zoneID},
+			ShippingRate: &rate,
+		})
+	}
+
+	for _, oldZoneRate := range oldZoneRates {
+		newZoneRate, ok := newLookup[oldZoneRate.zoneID]
+		if !ok {
+			for _, currency := range oldZoneRate.currencies() {
+				removeRate(oldZoneRate.zoneID, oldZoneRate.rates[currency])
+			}
+			actions = append(actions, &commercetools.ShippingMethodRemoveZoneAction{
+				Zone: &commercetools.ZoneResourceIdentifier{ID: oldZoneRate.zoneID},
+			})
+			continue
+		}
+
+		for _, currency := range oldZoneRate.currencies() {
+			oldRate := oldZoneRate.rates[currency]
+			newRate, ok := newZoneRate.rates[currency]
+			if !ok || !reflect.DeepEqual(oldRate, newRate) {
+				removeRate(oldZoneRate.zoneID, oldRate)
+			}
+		}
+		for _, currency := range newZoneRate.currencies() {
+			newRate := newZoneRate.rates[currency]
+			oldRate, ok := oldZoneRate.rates[currency]
+			if !ok || !reflect.DeepEqual(oldRate, newRate) {
+				addRate(newZoneRate.zoneID, newRate)
+			}
+		}
+	}
+
+	for _, newZoneRate := range newZoneRates {
+		if _, ok := oldLookup[newZoneRate.zoneID]; ok {
+			continue
+		}
+		actions = append(actions, &commercetools.ShippingMethodAddZoneAction{
+			Zone: &commercetools.ZoneResourceIdentifier{ID: newZoneRate.zoneID},
+		})
+		for _, currency := range newZoneRate.currencies() {
+			addRate(newZoneRate.zoneID, newZoneRate.rates[currency])
+		}
+	}
+
+	return actions, nil
+}
+
+// flattenShippingMethodZoneRates returns the zone rates in the order of the
+// current state. Zones and currencies which are not in the state are left
+// out, so they are not removed by the next update.
+func flattenShippingMethodZoneRates(zoneRates []commercetools.ZoneRate, state []interface{}) []map[string]interface{} {
+	stateZones := make(map[string]map[string]interface{})
+	zoneOrder := make(map[string]int)
+	for i, raw := range state {
+		item := raw.(map[string]interface{})
+		zoneID := item["shipping_zone_id"].(string)
+		stateZones[zoneID] = item
+		zoneOrder[zoneID] = i
+	}
+
+	result := []map[string]interface{}{}
+	for _, zoneRate := range zoneRates {
+		if zoneRate.Zone == nil {
+			continue
+		}
+
+		stateZone, ok := stateZones[zoneRate.Zone.ID]
+		if !ok {
+			continue
+		}
+
+		stateRates := make(map[string]map[string]interface{})
+		rateOrder := make(map[string]int)
+		for i, raw := range stateZone["shipping_rate"].([]interface{}) {
+			item := raw.(map[string]interface{})
+			if price := item["price"].([]interface{}); len(price) > 0 && price[0] != nil {
+				currency := price[0].(map[string]interface{})["currency_code"].(string)
+				stateRates[currency] = item
+				rateOrder[currency] = i
+			}
+		}
+
+		rates := []map[string]interface{}{}
+		for _, shippingRate := range zoneRate.ShippingRates {
+			price := flattenTypedMoney(shippingRate.Price)
+			if price == nil {
+				continue
+			}
+			stateRate, ok := stateRates[price["currency_code"].(string)]
+			if !ok {
+				continue
+			}
+			rate := map[string]interface{}{
+				"price":      []interface{}{price},
+				"free_above": []interface{}{},
+			}
+			if freeAbove := flattenTypedMoney(shippingRate.FreeAbove); freeAbove != nil {
+				rate["free_above"] = []interface{}{freeAbove}
+			}
+
+			rate["shipping_rate_price_tier"] = orderShippingRatePriceTiers(
+				flattenShippingRatePriceTiers(shippingRate.Tiers),
+				stateRate["shipping_rate_price_tier"].([]interface{}))
+			rates = append(rates, rate)
+		}
+		sort.SliceStable(rates, func(i, j int) bool {
+			return stateOrder(rateOrder, shippingRateCurrency(rates[i])) <
+				stateOrder(rateOrder, shippingRateCurrency(rates[j]))
+		})
+
+		result = append(result, map[string]interface{}{
+			"shipping_zone_id": zoneRate.Zone.ID,
+			"shipping_rate":    rates,
+		})
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return stateOrder(zoneOrder, result[i]["shipping_zone_id"].(string)) <
+			stateOrder(zoneOrder, result[j]["shipping_zone_id"].(string))
+	})
+	return result
+}
+
+func shippingRateCurrency(rate map[string]interface{}) string {
+	price := rate["price"].([]interface{})[0].(map[string]interface{})
+	return price["currency_code"].(string)
+}
+
+// stateOrder returns the position of the key in the state, keys which are
+// not in the state are sorted last
+func stateOrder(order map[string]int, key string) int {
+	if i, ok := order[key]; ok {
+		return i
+	}
+	return len(order)
+}
+
+type shippingMethodDraft struct {
+	*commercetools.ShippingMethodDraft
+	LocalizedName *commercetools.LocalizedString `json:"localizedName,omitempty"`
+}
+
+// shippingMethodExtraFields contains the shipping method fields which the
+// sdk can't read
+type shippingMethodExtraFields struct {
+	LocalizedName *commercetools.LocalizedString `json:"localizedName"`
+}
+
+type shippingMethodSetLocalizedNameAction struct {
+	Action        string                         `json:"action"`
+	LocalizedName *commercetools.LocalizedString `json:"localizedName,omitempty"`
+}
+
+type shippingMethodSetLocalizedDescriptionAction struct {
+	Action               string                         `json:"action"`
+	LocalizedDescription *commercetools.LocalizedString `json:"localizedDescription,omitempty"`
+}
@@ -6,8 +6,168 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/stretchr/testify/assert"
 )
 
+func testShippingMethodZoneRate(zoneID string, rates ...map[string]interface{}) map[string]interface{} {
+	shippingRates := make([]interface{}, len(rates))
+	for i, rate := range rates {
+		shippingRates[i] = rate
+	}
+	return map[string]interface{}{
+		"shipping_zone_id": zoneID,
+		"shipping_rate":    shippingRates,
+	}
+}
+
+func testShippingMethodShippingRate(currencyCode string, centAmount int) map[string]interface{} {
+	return map[string]interface{}{
+		"price": []interface{}{
+			map[string]interface{}{
+				"currency_code": currencyCode,
+				"cent_amount":   centAmount,
+			},
+		},
+		"free_above":               []interface{}{},
+		"shipping_rate_price_tier": []interface{}{},
+	}
+}
+
+func TestResourceShippingMethodZoneRateActions(t *testing.T) {
+	old := []interface{}{
+		testShippingMethodZoneRate("zone-nl",
+			testShippingMethodShippingRate("EUR", 500),
+			testShippingMethodShippingRate("USD", 600)),
+		testShippingMethodZoneRate("zone-de",
+			testShippingMethodShippingRate("EUR", 700)),
+	}
+	new := []interface{}{
+		testShippingMethodZoneRate("zone-nl",
+			testShippingMethodShippingRate("EUR", 450),
+			testShippingMethodShippingRate("USD", 600)),
+		testShippingMethodZoneRate("zone-be",
+			testShippingMethodShippingRate("EUR", 800)),
+	}
+
+	money := func(currencyCode string, centAmount int) *commercetools.ShippingRateDraft {
+		return &commercetools.ShippingRateDraft{
+			Price: &commercetools.Money{
+				CurrencyCode: commercetools.CurrencyCode(currencyCode),
+				CentAmount:   centAmount,
+			},
+		}
+	}
+
+	shippingMethod := &commercetools.ShippingMethod{
+		ID: "shipping-method-id",
+		ZoneRates: []commercetools.ZoneRate{
+			{
+				Zone: &commercetools.ZoneReference{ID: "zone-nl"},
+				ShippingRates: []commercetools.ShippingRate{
+					{Price: commercetools.CentPrecisionMoney{CurrencyCode: "EUR", CentAmount: 500}},
+					{Price: commercetools.CentPrecisionMoney{CurrencyCode: "USD", CentAmount: 600}},
+				},
+			},
+			{
+				Zone: &commercetools.ZoneReference{ID: "zone-de"},
+				ShippingRates: []commercetools.ShippingRate{
+					{Price: commercetools.CentPrecisionMoney{CurrencyCode: "EUR", CentAmount: 700}},
+				},
+			},
+		},
+	}
+
+	actions, err := resourceShippingMethodZoneRateActions(shippingMethod, old, new)
+	assert.NoError(t, err)
+	assert.Equal(t, []commercetools.ShippingMethodUpdateAction{
+		&commercetools.ShippingMethodRemoveShippingRateAction{
+			Zone:         &commercetools.ZoneResourceIdentifier{ID: "zone-nl"},
+			ShippingRate: money("EUR", 500),
+		},
+		&commercetools.ShippingMethodAddShippingRateAction{
+			Zone:         &commercetools.ZoneResourceIdentifier{ID: "zone-nl"},
+			ShippingRate: money("EUR", 450),
+		},
+		&commercetools.ShippingMethodRemoveShippingRateAction{
+			Zone:         &commercetools.ZoneResourceIdentifier{ID: "zone-de"},
+			ShippingRate: money("EUR", 700),
+		},
+		&commercetools.ShippingMethodRemoveZoneAction{
+			Zone: &commercetools.ZoneResourceIdentifier{ID: "zone-de"},
+		},
+		&commercetools.ShippingMethodAddZoneAction{
+			Zone: &commercetools.ZoneResourceIdentifier{ID: "zone-be"},
+		},
+		&commercetools.ShippingMethodAddShippingRateAction{
+			Zone:         &commercetools.ZoneResourceIdentifier{ID: "zone-be"},
+			ShippingRate: money("EUR", 800),
+		},
+	}, actions)
+
+	_, err = resourceShippingMethodZoneRateActions(shippingMethod, old, []interface{}{
+		testShippingMethodZoneRate("zone-nl",
+			testShippingMethodShippingRate("EUR", 500),
+			testShippingMethodShippingRate("EUR", 600)),
+	})
+	assert.Error(t, err)
+
+	// Zones and rates which are not in the old zone rates are managed by
+	// commercetools_shipping_zone_rate resources
+	shippingMethod.ZoneRates[0].ShippingRates = append(
+		shippingMethod.ZoneRates[0].ShippingRates,
+		commercetools.ShippingRate{Price: commercetools.CentPrecisionMoney{CurrencyCode: "GBP", CentAmount: 400}})
+	shippingMethod.ZoneRates = append(shippingMethod.ZoneRates, commercetools.ZoneRate{
+		Zone: &commercetools.ZoneReference{ID: "zone-fr"},
+	})
+	_, err = resourceShippingMethodZoneRateActions(shippingMethod, old, new)
+	assert.EqualError(t, err, "shipping method shipping-method-id has zone rates which are not managed "+
+		"by its zone_rate blocks: zone-fr, zone-nl/GBP, "+
+		"zone_rate blocks can not be combined with commercetools_shipping_zone_rate resources")
+}
+
+func TestFlattenShippingMethodZoneRates(t *testing.T) {
+	zoneRates := []commercetools.ZoneRate{
+		{
+			Zone: &commercetools.ZoneReference{ID: "zone-de"},
+			ShippingRates: []commercetools.ShippingRate{
+				{Price: commercetools.CentPrecisionMoney{CurrencyCode: "EUR", CentAmount: 700}},
+			},
+		},
+		{
+			Zone: &commercetools.ZoneReference{ID: "zone-nl"},
+			ShippingRates: []commercetools.ShippingRate{
+				{Price: commercetools.CentPrecisionMoney{CurrencyCode: "EUR", CentAmount: 500}},
+				{Price: commercetools.CentPrecisionMoney{CurrencyCode: "USD", CentAmount: 600}},
+				{Price: commercetools.CentPrecisionMoney{CurrencyCode: "GBP", CentAmount: 400}},
+			},
+		},
+		{
+			Zone: &commercetools.ZoneReference{ID: "zone-fr"},
+			ShippingRates: []commercetools.ShippingRate{
+				{Price: commercetools.CentPrecisionMoney{CurrencyCode: "EUR", CentAmount: 900}},
+			},
+		},
+	}
+	state := []interface{}{
+		testShippingMethodZoneRate("zone-nl",
+			testShippingMethodShippingRate("USD", 600),
+			testShippingMethodShippingRate("EUR", 500)),
+		testShippingMethodZoneRate("zone-de",
+			testShippingMethodShippingRate("EUR", 700)),
+	}
+
+	result := flattenShippingMethodZoneRates(zoneRates, state)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "zone-nl", result[0]["shipping_zone_id"])
+	assert.Equal(t, "zone-de", result[1]["shipping_zone_id"])
+
+	rates := result[0]["shipping_rate"].([]map[string]interface{})
+	assert.Len(t, rates, 2)
+	assert.Equal(t, "USD", shippingRateCurrency(rates[0]))
+	assert.Equal(t, "EUR", shippingRateCurrency(rates[1]))
+}
+
 func TestAccShippingMethod_createAndUpdateWithID(t *testing.T) {
 
 	name := "test sh method"
@@ -94,6 +254,149 @@ resource "commercetools_shipping_method" "standard" {
 	`, name, key, description, isDefault, predicate, taxCategoryReference) + "\n}\n"
 }
 
+func TestAccShippingMethod_localized(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckShippingMethodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShippingMethodLocalizedConfig("Express delivery", "Delivered tomorrow"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_shipping_method.localized", "localized_name.en", "Express delivery",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_shipping_method.localized", "localized_name.nl", "Snelle levering",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_shipping_method.localized", "localized_description.en", "Delivered tomorrow",
+					),
+				),
+			},
+			{
+				Config: testAccShippingMethodLocalizedConfig("Next day delivery", "Delivered the next working day"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_shipping_method.localized", "localized_name.en", "Next day delivery",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_shipping_method.localized", "localized_description.en", "Delivered the next working day",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccShippingMethodLocalizedConfig(name string, description string) string {
+	return fmt.Sprintf(`
+resource "commercetools_shipping_method" "localized" {
+	name = "express"
+	key  = "express"
+
+	localized_name = {
+		en = "%s"
+		nl = "Snelle levering"
+	}
+
+	localized_description = {
+		en = "%s"
+		nl = "Morgen geleverd"
+	}
+}
+`, name, description)
+}
+
+func TestAccShippingMethod_zoneRates(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckShippingMethodDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccShippingMethodZoneRatesConfig(500, "commercetools_shipping_zone.nl.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_shipping_method.inline", "zone_rate.#", "1",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_shipping_method.inline", "zone_rate.0.shipping_rate.#", "2",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_shipping_method.inline", "zone_rate.0.shipping_rate.0.price.0.cent_amount", "500",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_shipping_method.inline", "zone_rate.0.shipping_rate.0.free_above.0.cent_amount", "5000",
+					),
+				),
+			},
+			{
+				Config: testAccShippingMethodZoneRatesConfig(450, "commercetools_shipping_zone.de.id"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_shipping_method.inline", "zone_rate.#", "1",
+					),
+					resource.TestCheckResourceAttrPair(
+						"commercetools_shipping_method.inline", "zone_rate.0.shipping_zone_id",
+						"commercetools_shipping_zone.de", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_shipping_method.inline", "zone_rate.0.shipping_rate.0.price.0.cent_amount", "450",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccShippingMethodZoneRatesConfig(centAmount int, zoneID string) string {
+	return fmt.Sprintf(`
+resource "commercetools_shipping_zone" "nl" {
+	name = "NL"
+	location {
+		country = "NL"
+	}
+}
+
+resource "commercetools_shipping_zone" "de" {
+	name = "DE"
+	location {
+		country = "DE"
+	}
+}
+
+resource "commercetools_shipping_method" "inline" {
+	name = "inline"
+	key  = "inline"
+
+	zone_rate {
+		shipping_zone_id = %[2]s
+
+		shipping_rate {
+			price {
+				currency_code = "EUR"
+				cent_amount   = %[1]d
+			}
+			free_above {
+				currency_code = "EUR"
+				cent_amount   = 5000
+			}
+		}
+
+		shipping_rate {
+			price {
+				currency_code = "USD"
+				cent_amount   = 600
+			}
+		}
+	}
+}
+`, centAmount, zoneID)
+}
+
 func testAccCheckShippingMethodDestroy(s *terraform.State) error {
 	return nil
 }
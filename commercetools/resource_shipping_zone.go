@@ -39,8 +39,9 @@ func resourceShippingZone() *schema.Resource {
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"country": {
-							Type:     schema.TypeString,
-							Required: true,
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateCountryCode,
 						},
 						"state": {
 							Type:     schema.TypeString,
@@ -54,7 +55,15 @@ func resourceShippingZone() *schema.Resource {
 				Computed: true,
 			},
 		},
+		CustomizeDiff: resourceShippingZoneCustomizeDiff,
+	}
+}
+
+func resourceShippingZoneCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("location") || !d.NewValueKnown("location") {
+		return nil
 	}
+	return validateLocations(m, resourceShippingZoneGetLocation(d.Get("location")))
 }
 
 func resourceShippingZoneCreate(d *schema.ResourceData, m interface{}) error {
@@ -99,9 +108,8 @@ func resourceShippingZoneCreate(d *schema.ResourceData, m interface{}) error {
 
 func resourceShippingZoneRead(d *schema.ResourceData, m interface{}) error {
 	log.Print("[DEBUG] Reading shippingzones from commercetools")
-	client := getClient(m)
 
-	shippingZone, err := client.ZoneGetWithID(context.Background(), d.Id())
+	shippingZone, err := readShippingZone(m, d.Id())
 
 	if err != nil {
 		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
@@ -184,6 +192,7 @@ func resourceShippingZoneUpdate(d *schema.ResourceData, m interface{}) error {
 	}
 
 	_, err := client.ZoneUpdateWithID(context.Background(), input)
+	getReadCache(m).invalidate(shippingZoneCacheKey(d.Id()))
 	if err != nil {
 		return err
 	}
@@ -200,6 +209,7 @@ func resourceShippingZoneDelete(d *schema.ResourceData, m interface{}) error {
 
 	version := d.Get("version").(int)
 	_, err := client.ZoneDeleteWithID(context.Background(), d.Id(), version)
+	getReadCache(m).invalidate(shippingZoneCacheKey(d.Id()))
 	if err != nil {
 		return err
 	}
@@ -207,6 +217,28 @@ func resourceShippingZoneDelete(d *schema.ResourceData, m interface{}) error {
 	return nil
 }
 
+// readShippingZone returns the shipping zone from the read cache of the
+// provider, it is prefetched together with the other shipping zones when
+// enabled
+func readShippingZone(m interface{}, shippingZoneID string) (*commercetools.Zone, error) {
+	client := getClient(m)
+	value, err := readPrefetched(m, "shipping_zones", shippingZoneID, func() (interface{}, int, error) {
+		shippingZone, err := client.ZoneGetWithID(context.Background(), shippingZoneID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return shippingZone, shippingZone.Version, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*commercetools.Zone), nil
+}
+
+func shippingZoneCacheKey(shippingZoneID string) string {
+	return readCacheKey("zones", shippingZoneID)
+}
+
 func resourceShippingZoneGetLocation(input interface{}) []commercetools.Location {
 	inputSlice := input.([]interface{})
 	var result []commercetools.Location
@@ -233,6 +265,17 @@ func resourceShippingZoneGetLocation(input interface{}) []commercetools.Location
 	return result
 }
 
+func flattenShippingZoneLocations(locations []commercetools.Location) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(locations))
+	for i, location := range locations {
+		result[i] = map[string]interface{}{
+			"country": string(location.Country),
+			"state":   location.State,
+		}
+	}
+	return result
+}
+
 func _locationInSlice(needle commercetools.Location, haystack []commercetools.Location) bool {
 	for _, item := range haystack {
 		if item == needle {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/labd/commercetools-go-sdk/commercetools"
 )
 
@@ -72,15 +73,55 @@ func resourceShippingZoneRate() *schema.Resource {
 					},
 				},
 			},
+			"shipping_rate_price_tier": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: shippingRatePriceTierSchema(),
+				},
+			},
+		},
+	}
+}
+
+func shippingRatePriceTierSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"type": {
+			Type:     schema.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				"CartValue",
+				"CartClassification",
+				"CartScore",
+			}, false),
+		},
+		"minimum_cent_amount": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"value": {
+			Type:     schema.TypeString,
+			Optional: true,
+		},
+		"score": {
+			Type:     schema.TypeFloat,
+			Optional: true,
+		},
+		"price": {
+			Type:     schema.TypeInt,
+			Optional: true,
+		},
+		"price_function": {
+			Type:     schema.TypeString,
+			Optional: true,
 		},
 	}
 }
 
 func resourceShippingZoneRateImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
-	client := getClient(meta)
 	shippingMethodID, _, _ := getShippingIDs(d.Id())
 
-	shippingMethod, err := client.ShippingMethodGetWithID(context.Background(), shippingMethodID)
+	shippingMethod, err := readShippingMethod(meta, shippingMethodID)
 
 	if err != nil {
 		return nil, err
@@ -101,85 +142,45 @@ func resourceShippingZoneRateImportState(d *schema.ResourceData, meta interface{
 }
 
 func resourceShippingZoneRateCreate(d *schema.ResourceData, m interface{}) error {
-	client := getClient(m)
 	shippingZoneID := d.Get("shipping_zone_id").(string)
 	shippingMethodID := d.Get("shipping_method_id").(string)
 
-	// Lock to prevent concurrent updates due to Version number conflicts
-	ctMutexKV.Lock(shippingMethodID)
-	defer ctMutexKV.Unlock(shippingMethodID)
-
-	shippingMethod, err := client.ShippingMethodGetWithID(context.Background(), shippingMethodID)
-
-	if err != nil {
-		return err
-	}
-
-	input := commercetools.ShippingMethodUpdateWithIDInput{
-		ID:      shippingMethod.ID,
-		Version: shippingMethod.Version,
-		Actions: []commercetools.ShippingMethodUpdateAction{},
-	}
 	price := d.Get("price").([]interface{})[0].(map[string]interface{})
-	var freeAbove *commercetools.Money
-	if freeAboveState, ok := d.GetOk("free_above"); ok {
-		log.Printf("[DEBUG] Free above state: %s", stringFormatObject(freeAboveState))
-		freeAboveMap := freeAboveState.([]interface{})[0].(map[string]interface{})
-		freeAbove = &commercetools.Money{
-			CurrencyCode: commercetools.CurrencyCode(freeAboveMap["currency_code"].(string)),
-			CentAmount:   freeAboveMap["cent_amount"].(int),
-		}
-	}
-	log.Printf("[DEBUG] Setting freeAbove: %s", stringFormatObject(freeAbove))
-
 	priceCurrencyCode := commercetools.CurrencyCode(price["currency_code"].(string))
 
-	zoneNotFound := true
-	for _, v := range shippingMethod.ZoneRates {
-		if v.Zone.ID == shippingZoneID {
-			zoneNotFound = false
-			break
-		}
+	shippingRateDraft, err := expandShippingZoneRateDraft(d, priceCurrencyCode)
+	if err != nil {
+		return err
 	}
-
-	if zoneNotFound {
-		input.Actions = append(input.Actions, commercetools.ShippingMethodAddZoneAction{
-			Zone: &commercetools.ZoneResourceIdentifier{ID: shippingZoneID},
+	log.Printf("[DEBUG] Adding shipping rate: %s", stringFormatObject(shippingRateDraft))
+
+	// The rate is added in the same update as the other rates of the shipping
+	// method which are created or changed at the same time.
+	result, err := ctUpdateBatcher.submit(
+		shippingMethodID,
+		shippingMethodBatchHandler(m, shippingMethodID),
+		func(parent interface{}, pending []interface{}) ([]interface{}, error) {
+			shippingMethod := parent.(*commercetools.ShippingMethod)
+			actions := []interface{}{}
+			if !shippingMethodHasZone(shippingMethod, pending, shippingZoneID) {
+				actions = append(actions, &commercetools.ShippingMethodAddZoneAction{
+					Zone: &commercetools.ZoneResourceIdentifier{ID: shippingZoneID},
+				})
+			}
+			actions = append(actions, &commercetools.ShippingMethodAddShippingRateAction{
+				Zone:         &commercetools.ZoneResourceIdentifier{ID: shippingZoneID},
+				ShippingRate: shippingRateDraft,
+			})
+			return actions, nil
 		})
-	}
-
-	input.Actions = append(input.Actions, commercetools.ShippingMethodAddShippingRateAction{
-		Zone: &commercetools.ZoneResourceIdentifier{ID: shippingZoneID},
-		ShippingRate: &commercetools.ShippingRateDraft{
-			Price: &commercetools.Money{
-				CurrencyCode: priceCurrencyCode,
-				CentAmount:   price["cent_amount"].(int),
-			},
-			FreeAbove: freeAbove,
-		},
-	})
-
-	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
-		var err error
-
-		shippingMethod, err = client.ShippingMethodUpdateWithID(context.Background(), &input)
-		if err != nil {
-			return handleCommercetoolsError(err)
-		}
-		return nil
-	})
 
 	if err != nil {
 		return err
 	}
 
-	if shippingMethod == nil {
-		log.Fatal("No shipping method created?")
-	}
-
-	d.SetId(buildShippingZoneRateID(shippingMethod.ID, shippingZoneID, string(priceCurrencyCode)))
+	d.SetId(buildShippingZoneRateID(shippingMethodID, shippingZoneID, string(priceCurrencyCode)))
 
-	return resourceShippingZoneRateRead(d, m)
+	return setShippingZoneRateState(d, result.(*commercetools.ShippingMethod))
 }
 
 func buildShippingZoneRateID(shippingMethodID string, shippingZoneID string, currencyCode string) string {
@@ -191,9 +192,9 @@ func resourceShippingZoneRateRead(d *schema.ResourceData, m interface{}) error {
 
 	shippingMethodID, _, _ := getShippingIDs(d.Id())
 
-	client := getClient(m)
-
-	shippingMethod, err := client.ShippingMethodGetWithID(context.Background(), shippingMethodID)
+	// The shipping method is shared by all its zone rates, so it is read
+	// only once during a refresh
+	shippingMethod, err := readShippingMethod(m, shippingMethodID)
 
 	if err != nil {
 		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
@@ -223,154 +224,254 @@ func resourceShippingZoneRateRead(d *schema.ResourceData, m interface{}) error {
 
 func resourceShippingZoneRateUpdate(d *schema.ResourceData, m interface{}) error {
 	shippingMethodID, shippingZoneID, currencyCode := getShippingIDs(d.Id())
-	ctMutexKV.Lock(shippingMethodID)
-	defer ctMutexKV.Unlock(shippingMethodID)
 
-	client := getClient(m)
-	shippingMethod, err := client.ShippingMethodGetWithID(context.Background(), shippingMethodID)
+	if !d.HasChange("price") && !d.HasChange("free_above") && !d.HasChange("shipping_rate_price_tier") {
+		return resourceShippingZoneRateRead(d, m)
+	}
+
+	newShippingRateDraft, err := expandShippingZoneRateDraft(d, commercetools.CurrencyCode(currencyCode))
 	if err != nil {
 		return err
 	}
 
-	shippingRate, err := findShippingZoneRate(shippingZoneID, currencyCode, shippingMethod)
+	result, err := ctUpdateBatcher.submit(
+		shippingMethodID,
+		shippingMethodBatchHandler(m, shippingMethodID),
+		func(parent interface{}, pending []interface{}) ([]interface{}, error) {
+			shippingRate, err := findShippingZoneRate(
+				shippingZoneID, currencyCode, parent.(*commercetools.ShippingMethod))
+			if err != nil {
+				return nil, err
+			}
+
+			zoneResourceIdentifier := commercetools.ZoneResourceIdentifier{ID: shippingZoneID}
+			return []interface{}{
+				&commercetools.ShippingMethodRemoveShippingRateAction{
+					Zone:         &zoneResourceIdentifier,
+					ShippingRate: shippingRateDraftFromRate(shippingRate),
+				},
+				&commercetools.ShippingMethodAddShippingRateAction{
+					Zone:         &zoneResourceIdentifier,
+					ShippingRate: newShippingRateDraft,
+				},
+			}, nil
+		})
 
 	if err != nil {
 		return err
 	}
 
-	input := &commercetools.ShippingMethodUpdateWithIDInput{
-		ID:      shippingMethodID,
-		Version: shippingMethod.Version,
-		Actions: []commercetools.ShippingMethodUpdateAction{},
+	return setShippingZoneRateState(d, result.(*commercetools.ShippingMethod))
+}
+
+func resourceShippingZoneRateDelete(d *schema.ResourceData, m interface{}) error {
+	shippingMethodID := d.Get("shipping_method_id").(string)
+	shippingZoneID := d.Get("shipping_zone_id").(string)
+
+	price := d.Get("price").([]interface{})[0].(map[string]interface{})
+	priceCurrencyCode := commercetools.CurrencyCode(price["currency_code"].(string))
+
+	shippingRateDraft, err := expandShippingZoneRateDraft(d, priceCurrencyCode)
+	if err != nil {
+		return err
 	}
 
-	if d.HasChange("price") || d.HasChange("free_above") {
-		zoneResourceIdentifier := commercetools.ZoneResourceIdentifier{
-			ID: shippingZoneID,
-		}
+	_, err = ctUpdateBatcher.submit(
+		shippingMethodID,
+		shippingMethodBatchHandler(m, shippingMethodID),
+		func(parent interface{}, pending []interface{}) ([]interface{}, error) {
+			shippingMethod := parent.(*commercetools.ShippingMethod)
+			actions := []interface{}{
+				&commercetools.ShippingMethodRemoveShippingRateAction{
+					Zone:         &commercetools.ZoneResourceIdentifier{ID: shippingZoneID},
+					ShippingRate: shippingRateDraft,
+				},
+			}
 
-		oldTypedPrice := shippingRate.Price.(commercetools.CentPrecisionMoney)
-		var oldFreeAboveMoney *commercetools.Money
-		if shippingRate.FreeAbove != nil {
-			oldFreeAbove := shippingRate.FreeAbove.(commercetools.CentPrecisionMoney)
-			oldFreeAboveMoney = &commercetools.Money{
-				CurrencyCode: commercetools.CurrencyCode(currencyCode),
-				CentAmount:   oldFreeAbove.CentAmount,
+			// Remove the zone together with its last rate
+			if shippingZoneRateCount(shippingMethod, pending, shippingZoneID) == 1 {
+				actions = append(actions, &commercetools.ShippingMethodRemoveZoneAction{
+					Zone: &commercetools.ZoneResourceIdentifier{ID: shippingZoneID},
+				})
 			}
-		}
+			return actions, nil
+		})
 
-		oldShippingRateDraft := commercetools.ShippingRateDraft{
-			Price: &commercetools.Money{
-				CurrencyCode: commercetools.CurrencyCode(currencyCode),
-				CentAmount:   oldTypedPrice.CentAmount,
-			},
-			FreeAbove: oldFreeAboveMoney,
+	return err
+}
+
+// readShippingMethod returns the shipping method from the read cache of the
+// provider, it is prefetched together with the other shipping methods when
+// enabled
+func readShippingMethod(m interface{}, shippingMethodID string) (*commercetools.ShippingMethod, error) {
+	client := getClient(m)
+	value, err := readPrefetched(m, "shipping_methods", shippingMethodID, func() (interface{}, int, error) {
+		shippingMethod, err := client.ShippingMethodGetWithID(context.Background(), shippingMethodID)
+		if err != nil {
+			return nil, 0, err
 		}
+		return shippingMethod, shippingMethod.Version, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*commercetools.ShippingMethod), nil
+}
+
+func shippingMethodCacheKey(shippingMethodID string) string {
+	return readCacheKey("shipping-methods", shippingMethodID)
+}
 
-		price := d.Get("price").([]interface{})[0].(map[string]interface{})
-		var newFreeAboveMoney *commercetools.Money
-		if freeAbove, ok := d.GetOk("free_above"); ok {
-			freeAboveMap := freeAbove.([]interface{})[0].(map[string]interface{})
-			newFreeAboveMoney = &commercetools.Money{
-				CurrencyCode: commercetools.CurrencyCode(currencyCode),
-				CentAmount:   freeAboveMap["cent_amount"].(int),
+// shippingMethodBatchHandler reads and updates a shipping method for the
+// batched updates of its zone rates. The batch always reads the current
+// version of the shipping method and stores the result in the read cache.
+func shippingMethodBatchHandler(m interface{}, shippingMethodID string) updateBatchHandler {
+	client := getClient(m)
+	cache := getReadCache(m)
+	return updateBatchHandler{
+		fetch: func() (interface{}, error) {
+			shippingMethod, err := client.ShippingMethodGetWithID(context.Background(), shippingMethodID)
+			if err != nil {
+				return nil, err
+			}
+			cache.store(shippingMethodCacheKey(shippingMethodID), shippingMethod, shippingMethod.Version)
+			return shippingMethod, nil
+		},
+		apply: func(parent interface{}, actions []interface{}) (interface{}, error) {
+			input := &commercetools.ShippingMethodUpdateWithIDInput{
+				ID:      shippingMethodID,
+				Version: parent.(*commercetools.ShippingMethod).Version,
+				Actions: []commercetools.ShippingMethodUpdateAction{},
+			}
+			for _, action := range actions {
+				input.Actions = append(input.Actions, action)
 			}
-		}
 
-		newShippingRateDraft := commercetools.ShippingRateDraft{
-			Price: &commercetools.Money{
-				CurrencyCode: commercetools.CurrencyCode(currencyCode),
-				CentAmount:   price["cent_amount"].(int),
-			},
-			FreeAbove: newFreeAboveMoney,
-		}
+			log.Printf(
+				"[DEBUG] Will perform update operation with the following actions:\n%s",
+				stringFormatActions(input.Actions))
 
-		input.Actions = append(
-			input.Actions,
-			&commercetools.ShippingMethodRemoveShippingRateAction{
-				Zone: &commercetools.ZoneResourceIdentifier{
-					ID: shippingZoneID,
-				},
-				ShippingRate: &oldShippingRateDraft,
-			})
-		input.Actions = append(
-			input.Actions,
-			&commercetools.ShippingMethodAddShippingRateAction{
-				Zone:         &zoneResourceIdentifier,
-				ShippingRate: &newShippingRateDraft,
+			var shippingMethod *commercetools.ShippingMethod
+			err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+				var err error
+				shippingMethod, err = client.ShippingMethodUpdateWithID(context.Background(), input)
+				if err != nil {
+					return handleCommercetoolsError(err)
+				}
+				return nil
 			})
+			if err != nil {
+				if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+					log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
+				}
+				return nil, err
+			}
+			cache.store(shippingMethodCacheKey(shippingMethodID), shippingMethod, shippingMethod.Version)
+			return shippingMethod, nil
+		},
 	}
+}
 
-	log.Printf(
-		"[DEBUG] Will perform update operation with the following actions:\n%s",
-		stringFormatActions(input.Actions))
-
-	_, err = client.ShippingMethodUpdateWithID(context.Background(), input)
-	if err != nil {
-		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
-			log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
+// shippingMethodHasZone returns whether the zone is part of the shipping
+// method, taking the pending actions of the batch into account
+func shippingMethodHasZone(shippingMethod *commercetools.ShippingMethod, pending []interface{}, shippingZoneID string) bool {
+	found := false
+	for _, zoneRate := range shippingMethod.ZoneRates {
+		if zoneRate.Zone != nil && zoneRate.Zone.ID == shippingZoneID {
+			found = true
 		}
-		return err
 	}
-
-	return resourceShippingZoneRateRead(d, m)
+	for _, action := range pending {
+		switch a := action.(type) {
+		case *commercetools.ShippingMethodAddZoneAction:
+			if a.Zone.ID == shippingZoneID {
+				found = true
+			}
+		case *commercetools.ShippingMethodRemoveZoneAction:
+			if a.Zone.ID == shippingZoneID {
+				found = false
+			}
+		}
+	}
+	return found
 }
 
-func resourceShippingZoneRateDelete(d *schema.ResourceData, m interface{}) error {
-	shippingMethodID := d.Get("shipping_method_id").(string)
-	ctMutexKV.Lock(shippingMethodID)
-	defer ctMutexKV.Unlock(shippingMethodID)
-
-	client := getClient(m)
-	shippingMethod, err := client.ShippingMethodGetWithID(context.Background(), shippingMethodID)
-	if err != nil {
-		return err
+// shippingZoneRateCount returns the number of rates of the zone, taking the
+// pending actions of the batch into account
+func shippingZoneRateCount(shippingMethod *commercetools.ShippingMethod, pending []interface{}, shippingZoneID string) int {
+	count := 0
+	for _, zoneRate := range shippingMethod.ZoneRates {
+		if zoneRate.Zone != nil && zoneRate.Zone.ID == shippingZoneID {
+			count += len(zoneRate.ShippingRates)
+		}
 	}
-
-	input := &commercetools.ShippingMethodUpdateWithIDInput{
-		ID:      shippingMethod.ID,
-		Version: shippingMethod.Version,
-		Actions: []commercetools.ShippingMethodUpdateAction{},
+	for _, action := range pending {
+		switch a := action.(type) {
+		case *commercetools.ShippingMethodAddShippingRateAction:
+			if a.Zone.ID == shippingZoneID {
+				count++
+			}
+		case *commercetools.ShippingMethodRemoveShippingRateAction:
+			if a.Zone.ID == shippingZoneID {
+				count--
+			}
+		}
 	}
+	return count
+}
 
+// expandShippingZoneRateDraft returns the shipping rate as configured in the
+// resource
+func expandShippingZoneRateDraft(d *schema.ResourceData, currencyCode commercetools.CurrencyCode) (*commercetools.ShippingRateDraft, error) {
 	price := d.Get("price").([]interface{})[0].(map[string]interface{})
-	var newFreeAboveMoney *commercetools.Money
+
+	draft := &commercetools.ShippingRateDraft{
+		Price: &commercetools.Money{
+			CurrencyCode: currencyCode,
+			CentAmount:   price["cent_amount"].(int),
+		},
+	}
+
 	if freeAbove, ok := d.GetOk("free_above"); ok {
 		freeAboveMap := freeAbove.([]interface{})[0].(map[string]interface{})
-		newFreeAboveMoney = &commercetools.Money{
+		draft.FreeAbove = &commercetools.Money{
 			CurrencyCode: commercetools.CurrencyCode(freeAboveMap["currency_code"].(string)),
 			CentAmount:   freeAboveMap["cent_amount"].(int),
 		}
 	}
-	shippingZoneID := d.Get("shipping_zone_id").(string)
-	removeAction := commercetools.ShippingMethodRemoveShippingRateAction{
-		Zone: &commercetools.ZoneResourceIdentifier{ID: shippingZoneID},
-		ShippingRate: &commercetools.ShippingRateDraft{
-			Price: &commercetools.Money{
-				CurrencyCode: commercetools.CurrencyCode(price["currency_code"].(string)),
-				CentAmount:   price["cent_amount"].(int),
-			},
-			FreeAbove: newFreeAboveMoney,
-		},
+
+	tiers, err := expandShippingRatePriceTiers(
+		d.Get("shipping_rate_price_tier").([]interface{}), currencyCode)
+	if err != nil {
+		return nil, err
 	}
+	draft.Tiers = tiers
 
-	input.Actions = append(input.Actions, removeAction)
+	return draft, nil
+}
 
-	for _, v := range shippingMethod.ZoneRates {
-		if v.Zone.ID == shippingZoneID && len(v.ShippingRates) == 1 {
-			input.Actions = append(input.Actions, commercetools.ShippingMethodRemoveZoneAction{
-				Zone: &commercetools.ZoneResourceIdentifier{ID: shippingZoneID},
-			})
-			break
-		}
+// shippingRateDraftFromRate converts an existing shipping rate to a draft, as
+// needed to remove it from the shipping method
+func shippingRateDraftFromRate(shippingRate *commercetools.ShippingRate) *commercetools.ShippingRateDraft {
+	draft := &commercetools.ShippingRateDraft{
+		Price: expandTypedMoneyToMoney(shippingRate.Price),
+		Tiers: shippingRate.Tiers,
 	}
-
-	_, err = client.ShippingMethodUpdateWithID(context.Background(), input)
-	if err != nil {
-		return err
+	if shippingRate.FreeAbove != nil {
+		draft.FreeAbove = expandTypedMoneyToMoney(shippingRate.FreeAbove)
 	}
+	return draft
+}
 
-	return nil
+func expandTypedMoneyToMoney(money commercetools.TypedMoney) *commercetools.Money {
+	flattened := flattenTypedMoney(money)
+	if flattened == nil {
+		return nil
+	}
+	return &commercetools.Money{
+		CurrencyCode: commercetools.CurrencyCode(flattened["currency_code"].(string)),
+		CentAmount:   flattened["cent_amount"].(int),
+	}
 }
 
 func getShippingIDs(shippingZoneRateID string) (string, string, string) {
@@ -432,7 +533,142 @@ func setShippingZoneRateState(d *schema.ResourceData, shippingMethod *commerceto
 			return err
 		}
 	}
+
+	tiers := orderShippingRatePriceTiers(
+		flattenShippingRatePriceTiers(shippingRate.Tiers),
+		d.Get("shipping_rate_price_tier").([]interface{}))
+	err = d.Set("shipping_rate_price_tier", tiers)
+	if err != nil {
+		return err
+	}
 	log.Printf("[DEBUG] New state: %#v", d)
 
 	return nil
 }
+
+// expandShippingRatePriceTiers converts the shipping_rate_price_tier blocks
+// to price tiers. All prices of the tiers use the currency of the rate.
+func expandShippingRatePriceTiers(input []interface{}, currencyCode commercetools.CurrencyCode) ([]commercetools.ShippingRatePriceTier, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	tiers := make([]commercetools.ShippingRatePriceTier, len(input))
+	for i, raw := range input {
+		tier := raw.(map[string]interface{})
+		price := &commercetools.Money{
+			CurrencyCode: currencyCode,
+			CentAmount:   tier["price"].(int),
+		}
+
+		switch tier["type"].(string) {
+		case "CartValue":
+			tiers[i] = commercetools.CartValueTier{
+				MinimumCentAmount: tier["minimum_cent_amount"].(int),
+				Price:             price,
+			}
+		case "CartClassification":
+			if tier["value"].(string) == "" {
+				return nil, fmt.Errorf("value is required for a CartClassification tier")
+			}
+			tiers[i] = commercetools.CartClassificationTier{
+				Value: tier["value"].(string),
+				Price: price,
+			}
+		case "CartScore":
+			scoreTier := commercetools.CartScoreTier{
+				Score: tier["score"].(float64),
+			}
+			if function := tier["price_function"].(string); function != "" {
+				scoreTier.PriceFunction = &commercetools.PriceFunction{
+					Function:     function,
+					CurrencyCode: currencyCode,
+				}
+			} else {
+				scoreTier.Price = price
+			}
+			tiers[i] = scoreTier
+		default:
+			return nil, fmt.Errorf("unknown shipping rate price tier type %s", tier["type"])
+		}
+	}
+	return tiers, nil
+}
+
+func flattenShippingRatePriceTiers(tiers []commercetools.ShippingRatePriceTier) []map[string]interface{} {
+	result := []map[string]interface{}{}
+	for _, tier := range tiers {
+		switch t := tier.(type) {
+		case commercetools.CartValueTier:
+			result = append(result, map[string]interface{}{
+				"type":                "CartValue",
+				"minimum_cent_amount": t.MinimumCentAmount,
+				"price":               flattenShippingRatePriceTierPrice(t.Price),
+			})
+		case commercetools.CartClassificationTier:
+			result = append(result, map[string]interface{}{
+				"type":  "CartClassification",
+				"value": t.Value,
+				"price": flattenShippingRatePriceTierPrice(t.Price),
+			})
+		case commercetools.CartScoreTier:
+			item := map[string]interface{}{
+				"type":  "CartScore",
+				"score": t.Score,
+				"price": flattenShippingRatePriceTierPrice(t.Price),
+			}
+			if t.PriceFunction != nil {
+				item["price_function"] = t.PriceFunction.Function
+			}
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+func flattenShippingRatePriceTierPrice(price *commercetools.Money) int {
+	if price == nil {
+		return 0
+	}
+	return price.CentAmount
+}
+
+// shippingRatePriceTierKey identifies a tier within a rate, there can only be
+// one tier per minimum amount, classification value or score.
+func shippingRatePriceTierKey(tier map[string]interface{}) string {
+	switch tier["type"] {
+	case "CartValue":
+		return fmt.Sprintf("CartValue:%v", tier["minimum_cent_amount"])
+	case "CartClassification":
+		return fmt.Sprintf("CartClassification:%v", tier["value"])
+	case "CartScore":
+		return fmt.Sprintf("CartScore:%v", tier["score"])
+	}
+	return ""
+}
+
+// orderShippingRatePriceTiers returns the tiers in the order of the current
+// state, so that reading the tiers back does not result in a diff when
+// commercetools returns them in a different order. Tiers which are not in
+// the state are added at the end, in the order commercetools returned them.
+func orderShippingRatePriceTiers(tiers []map[string]interface{}, state []interface{}) []map[string]interface{} {
+	lookup := make(map[string]map[string]interface{})
+	for _, tier := range tiers {
+		lookup[shippingRatePriceTierKey(tier)] = tier
+	}
+
+	result := []map[string]interface{}{}
+	for _, raw := range state {
+		key := shippingRatePriceTierKey(raw.(map[string]interface{}))
+		if tier, ok := lookup[key]; ok {
+			result = append(result, tier)
+			delete(lookup, key)
+		}
+	}
+	for _, tier := range tiers {
+		if _, ok := lookup[shippingRatePriceTierKey(tier)]; ok {
+			result = append(result, tier)
+		}
+	}
+	return result
+}
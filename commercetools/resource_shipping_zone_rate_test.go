@@ -8,8 +8,80 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestExpandShippingRatePriceTiers(t *testing.T) {
+	input := []interface{}{
+		map[string]interface{}{
+			"type":                "CartValue",
+			"minimum_cent_amount": 5000,
+			"value":               "",
+			"score":               0.0,
+			"price":               250,
+			"price_function":      "",
+		},
+		map[string]interface{}{
+			"type":                "CartClassification",
+			"minimum_cent_amount": 0,
+			"value":               "Heavy",
+			"score":               0.0,
+			"price":               1500,
+			"price_function":      "",
+		},
+		map[string]interface{}{
+			"type":                "CartScore",
+			"minimum_cent_amount": 0,
+			"value":               "",
+			"score":               2.5,
+			"price":               0,
+			"price_function":      "(50 * x) + 4950",
+		},
+	}
+
+	tiers, err := expandShippingRatePriceTiers(input, "EUR")
+	assert.NoError(t, err)
+	assert.Equal(t, []commercetools.ShippingRatePriceTier{
+		commercetools.CartValueTier{
+			MinimumCentAmount: 5000,
+			Price:             &commercetools.Money{CurrencyCode: "EUR", CentAmount: 250},
+		},
+		commercetools.CartClassificationTier{
+			Value: "Heavy",
+			Price: &commercetools.Money{CurrencyCode: "EUR", CentAmount: 1500},
+		},
+		commercetools.CartScoreTier{
+			Score: 2.5,
+			PriceFunction: &commercetools.PriceFunction{
+				Function:     "(50 * x) + 4950",
+				CurrencyCode: "EUR",
+			},
+		},
+	}, tiers)
+
+	flattened := flattenShippingRatePriceTiers(tiers)
+	assert.Equal(t, 5000, flattened[0]["minimum_cent_amount"])
+	assert.Equal(t, "Heavy", flattened[1]["value"])
+	assert.Equal(t, 2.5, flattened[2]["score"])
+	assert.Equal(t, "(50 * x) + 4950", flattened[2]["price_function"])
+}
+
+func TestOrderShippingRatePriceTiers(t *testing.T) {
+	tiers := []map[string]interface{}{
+		{"type": "CartValue", "minimum_cent_amount": 1000, "price": 500},
+		{"type": "CartValue", "minimum_cent_amount": 5000, "price": 0},
+		{"type": "CartValue", "minimum_cent_amount": 2500, "price": 250},
+	}
+	state := []interface{}{
+		map[string]interface{}{"type": "CartValue", "minimum_cent_amount": 5000, "price": 0},
+		map[string]interface{}{"type": "CartValue", "minimum_cent_amount": 1000, "price": 500},
+	}
+
+	result := orderShippingRatePriceTiers(tiers, state)
+	assert.Equal(t, []map[string]interface{}{tiers[1], tiers[0], tiers[2]}, result)
+}
+
 func TestAccShippingZoneRate_create(t *testing.T) {
 
 	taxCategoryName := acctest.RandomWithPrefix("tf-acc-test")
@@ -2,12 +2,42 @@ package commercetools
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
 
+func TestAccShippingZone_invalidLocation(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccShippingZoneLocationConfig("UK", ""),
+				ExpectError: regexp.MustCompile("unknown country code"),
+			},
+			{
+				Config:      testAccShippingZoneLocationConfig("US", "Nevda"),
+				ExpectError: regexp.MustCompile("unknown state Nevda for country US"),
+			},
+		},
+	})
+}
+
+func testAccShippingZoneLocationConfig(country string, state string) string {
+	return fmt.Sprintf(`
+resource "commercetools_shipping_zone" "invalid" {
+	name = "invalid"
+	location {
+		country = "%s"
+		state   = "%s"
+	}
+}`, country, state)
+}
+
 func TestAccShippingZone_createAndUpdateWithID(t *testing.T) {
 
 	key := "key"
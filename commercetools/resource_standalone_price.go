@@ -0,0 +1,495 @@
+package commercetools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+// Standalone prices are not available in the commercetools-go-sdk, so this
+// resource uses the rest client with the types defined at the bottom of this
+// file.
+func resourceStandalonePrice() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceStandalonePriceCreate,
+		Read:   resourceStandalonePriceRead,
+		Update: resourceStandalonePriceUpdate,
+		Delete: resourceStandalonePriceDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceStandalonePriceImportState,
+		},
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sku": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"currency_code": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: ValidateCurrencyCode,
+						},
+						"cent_amount": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"customer_group_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"channel_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"valid_from": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: diffSuppressDate,
+			},
+			"valid_until": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: diffSuppressDate,
+			},
+			"tier": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"minimum_quantity": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(2),
+						},
+						"cent_amount": {
+							Type:     schema.TypeInt,
+							Required: true,
+						},
+					},
+				},
+			},
+			"active": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"version": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceStandalonePriceCreate(d *schema.ResourceData, m interface{}) error {
+	client := getRestClient(m)
+	var standalonePrice *standalonePriceResult
+
+	value := resourceStandalonePriceGetValue(d)
+	draft := &standalonePriceDraft{
+		Key:     d.Get("key").(string),
+		SKU:     d.Get("sku").(string),
+		Value:   value,
+		Country: commercetools.CountryCode(d.Get("country").(string)),
+		Tiers:   expandStandalonePriceTiers(d.Get("tier").(*schema.Set), value.CurrencyCode),
+		Active:  d.Get("active").(bool),
+	}
+
+	if val := d.Get("customer_group_id").(string); val != "" {
+		draft.CustomerGroup = &commercetools.CustomerGroupResourceIdentifier{ID: val}
+	}
+	if val := d.Get("channel_id").(string); val != "" {
+		draft.Channel = &commercetools.ChannelResourceIdentifier{ID: val}
+	}
+	if val := d.Get("valid_from").(string); val != "" {
+		validFrom, err := expandDate(val)
+		if err != nil {
+			return err
+		}
+		draft.ValidFrom = &validFrom
+	}
+	if val := d.Get("valid_until").(string); val != "" {
+		validUntil, err := expandDate(val)
+		if err != nil {
+			return err
+		}
+		draft.ValidUntil = &validUntil
+	}
+
+	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+		var err error
+
+		standalonePrice, err = standalonePriceCreate(client, draft)
+		if err != nil {
+			return handleCommercetoolsError(err)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(standalonePrice.ID)
+	d.Set("version", standalonePrice.Version)
+
+	return resourceStandalonePriceRead(d, m)
+}
+
+func resourceStandalonePriceRead(d *schema.ResourceData, m interface{}) error {
+	log.Printf("[DEBUG] Reading standalone price from commercetools, with standalone price id: %s", d.Id())
+	client := getRestClient(m)
+
+	standalonePrice, err := standalonePriceGet(client, fmt.Sprintf("standalone-prices/%s", d.Id()))
+
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			if ctErr.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+		}
+		return err
+	}
+
+	log.Print("[DEBUG] Found following standalone price:")
+	log.Print(stringFormatObject(standalonePrice))
+
+	d.Set("version", standalonePrice.Version)
+	d.Set("key", standalonePrice.Key)
+	d.Set("sku", standalonePrice.SKU)
+	if money := flattenTypedMoney(standalonePrice.Value); money != nil {
+		d.Set("value", []map[string]interface{}{money})
+	}
+	d.Set("country", string(standalonePrice.Country))
+	if standalonePrice.CustomerGroup != nil {
+		d.Set("customer_group_id", standalonePrice.CustomerGroup.ID)
+	} else {
+		d.Set("customer_group_id", "")
+	}
+	if standalonePrice.Channel != nil {
+		d.Set("channel_id", standalonePrice.Channel.ID)
+	} else {
+		d.Set("channel_id", "")
+	}
+	d.Set("valid_from", flattenDate(standalonePrice.ValidFrom))
+	d.Set("valid_until", flattenDate(standalonePrice.ValidUntil))
+	if err := d.Set("tier", flattenStandalonePriceTiers(standalonePrice.Tiers)); err != nil {
+		return err
+	}
+	d.Set("active", standalonePrice.Active)
+
+	return nil
+}
+
+func resourceStandalonePriceUpdate(d *schema.ResourceData, m interface{}) error {
+	client := getRestClient(m)
+
+	actions := []interface{}{}
+
+	if d.HasChange("key") {
+		actions = append(actions, standalonePriceSetKeyAction{
+			Action: "setKey",
+			Key:    d.Get("key").(string),
+		})
+	}
+
+	// Tiers are removed before the value is changed and added afterwards, as
+	// the tiers need to have the same currency as the value.
+	tierRemovals, tierAdditions := resourceStandalonePriceTierActions(d)
+	actions = append(actions, tierRemovals...)
+
+	if d.HasChange("value") {
+		value := resourceStandalonePriceGetValue(d)
+		actions = append(actions, standalonePriceChangeValueAction{
+			Action: "changeValue",
+			Value:  value,
+		})
+	}
+
+	if d.HasChange("valid_from") {
+		action := standalonePriceSetValidFromAction{Action: "setValidFrom"}
+		if val := d.Get("valid_from").(string); val != "" {
+			validFrom, err := expandDate(val)
+			if err != nil {
+				return err
+			}
+			action.ValidFrom = &validFrom
+		}
+		actions = append(actions, action)
+	}
+
+	if d.HasChange("valid_until") {
+		action := standalonePriceSetValidUntilAction{Action: "setValidUntil"}
+		if val := d.Get("valid_until").(string); val != "" {
+			validUntil, err := expandDate(val)
+			if err != nil {
+				return err
+			}
+			action.ValidUntil = &validUntil
+		}
+		actions = append(actions, action)
+	}
+
+	actions = append(actions, tierAdditions...)
+
+	if d.HasChange("active") {
+		actions = append(actions, standalonePriceChangeActiveAction{
+			Action: "changeActive",
+			Active: d.Get("active").(bool),
+		})
+	}
+
+	log.Printf(
+		"[DEBUG] Will perform update operation with the following actions:\n%s",
+		stringFormatActions(actions))
+
+	err := client.update(
+		context.Background(), fmt.Sprintf("standalone-prices/%s", d.Id()),
+		d.Get("version").(int), actions, nil)
+	if err != nil {
+		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+			log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
+		}
+		return err
+	}
+
+	return resourceStandalonePriceRead(d, m)
+}
+
+func resourceStandalonePriceDelete(d *schema.ResourceData, m interface{}) error {
+	client := getRestClient(m)
+	version := d.Get("version").(int)
+	err := client.delete(context.Background(), fmt.Sprintf("standalone-prices/%s", d.Id()), version, nil)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// resourceStandalonePriceImportState imports a standalone price by its key
+func resourceStandalonePriceImportState(d *schema.ResourceData, m interface{}) ([]*schema.ResourceData, error) {
+	client := getRestClient(m)
+
+	standalonePrice, err := standalonePriceGet(client, fmt.Sprintf("standalone-prices/key=%s", d.Id()))
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(standalonePrice.ID)
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceStandalonePriceTierActions returns the actions to remove the tiers
+// which are removed or changed and the actions to add the new and changed
+// tiers. When the currency changed all tiers are replaced.
+func resourceStandalonePriceTierActions(d *schema.ResourceData) ([]interface{}, []interface{}) {
+	oldValue, newValue := d.GetChange("value")
+	oldCurrency := standalonePriceCurrency(oldValue.([]interface{}))
+	newCurrency := standalonePriceCurrency(newValue.([]interface{}))
+
+	old, new := d.GetChange("tier")
+	oldTiers := old.(*schema.Set)
+	newTiers := new.(*schema.Set)
+
+	removed := oldTiers.Difference(newTiers).List()
+	added := newTiers.Difference(oldTiers).List()
+	if oldCurrency != newCurrency {
+		removed = oldTiers.List()
+		added = newTiers.List()
+	}
+
+	removals := []interface{}{}
+	for _, tier := range sortStandalonePriceTiers(removed) {
+		removals = append(removals, standalonePriceRemovePriceTierAction{
+			Action:              "removePriceTier",
+			TierMinimumQuantity: tier["minimum_quantity"].(int),
+		})
+	}
+
+	additions := []interface{}{}
+	for _, tier := range sortStandalonePriceTiers(added) {
+		additions = append(additions, standalonePriceAddPriceTierAction{
+			Action: "addPriceTier",
+			Tier: commercetools.PriceTierDraft{
+				MinimumQuantity: tier["minimum_quantity"].(int),
+				Value: &commercetools.Money{
+					CurrencyCode: commercetools.CurrencyCode(newCurrency),
+					CentAmount:   tier["cent_amount"].(int),
+				},
+			},
+		})
+	}
+	return removals, additions
+}
+
+func resourceStandalonePriceGetValue(d *schema.ResourceData) *commercetools.Money {
+	value := d.Get("value").([]interface{})[0].(map[string]interface{})
+	return &commercetools.Money{
+		CurrencyCode: commercetools.CurrencyCode(value["currency_code"].(string)),
+		CentAmount:   value["cent_amount"].(int),
+	}
+}
+
+func standalonePriceCurrency(value []interface{}) string {
+	if len(value) == 0 || value[0] == nil {
+		return ""
+	}
+	return value[0].(map[string]interface{})["currency_code"].(string)
+}
+
+func sortStandalonePriceTiers(input []interface{}) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(input))
+	for _, raw := range input {
+		result = append(result, raw.(map[string]interface{}))
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i]["minimum_quantity"].(int) < result[j]["minimum_quantity"].(int)
+	})
+	return result
+}
+
+func expandStandalonePriceTiers(input *schema.Set, currencyCode commercetools.CurrencyCode) []commercetools.PriceTierDraft {
+	result := []commercetools.PriceTierDraft{}
+	for _, tier := range sortStandalonePriceTiers(input.List()) {
+		result = append(result, commercetools.PriceTierDraft{
+			MinimumQuantity: tier["minimum_quantity"].(int),
+			Value: &commercetools.Money{
+				CurrencyCode: currencyCode,
+				CentAmount:   tier["cent_amount"].(int),
+			},
+		})
+	}
+	return result
+}
+
+func flattenStandalonePriceTiers(tiers []commercetools.PriceTier) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(tiers))
+	for _, tier := range tiers {
+		item := map[string]interface{}{
+			"minimum_quantity": tier.MinimumQuantity,
+		}
+		if money := flattenTypedMoney(tier.Value); money != nil {
+			item["cent_amount"] = money["cent_amount"]
+		}
+		result = append(result, item)
+	}
+	return result
+}
+
+func standalonePriceCreate(client *restClient, draft *standalonePriceDraft) (*standalonePriceResult, error) {
+	var data json.RawMessage
+	if err := client.create(context.Background(), "standalone-prices", draft, &data); err != nil {
+		return nil, err
+	}
+	return decodeStandalonePrice(data)
+}
+
+func standalonePriceGet(client *restClient, endpoint string) (*standalonePriceResult, error) {
+	var data json.RawMessage
+	if err := client.get(context.Background(), endpoint, nil, &data); err != nil {
+		return nil, err
+	}
+	return decodeStandalonePrice(data)
+}
+
+// decodeStandalonePrice decodes the price fields with the sdk Price type, so
+// the money values are decoded the same way as product prices.
+func decodeStandalonePrice(data json.RawMessage) (*standalonePriceResult, error) {
+	result := &standalonePriceResult{}
+	if err := json.Unmarshal(data, &result.Price); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &result.standalonePriceFields); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+type standalonePriceFields struct {
+	Version int    `json:"version"`
+	Key     string `json:"key,omitempty"`
+	SKU     string `json:"sku"`
+	Active  bool   `json:"active"`
+}
+
+type standalonePriceResult struct {
+	commercetools.Price
+	standalonePriceFields
+}
+
+type standalonePriceDraft struct {
+	Key           string                                         `json:"key,omitempty"`
+	SKU           string                                         `json:"sku"`
+	Value         *commercetools.Money                           `json:"value"`
+	Country       commercetools.CountryCode                      `json:"country,omitempty"`
+	CustomerGroup *commercetools.CustomerGroupResourceIdentifier `json:"customerGroup,omitempty"`
+	Channel       *commercetools.ChannelResourceIdentifier       `json:"channel,omitempty"`
+	ValidFrom     *time.Time                                     `json:"validFrom,omitempty"`
+	ValidUntil    *time.Time                                     `json:"validUntil,omitempty"`
+	Tiers         []commercetools.PriceTierDraft                 `json:"tiers,omitempty"`
+	Active        bool                                           `json:"active"`
+}
+
+type standalonePriceSetKeyAction struct {
+	Action string `json:"action"`
+	Key    string `json:"key,omitempty"`
+}
+
+type standalonePriceChangeValueAction struct {
+	Action string               `json:"action"`
+	Value  *commercetools.Money `json:"value"`
+}
+
+type standalonePriceSetValidFromAction struct {
+	Action    string     `json:"action"`
+	ValidFrom *time.Time `json:"validFrom,omitempty"`
+}
+
+type standalonePriceSetValidUntilAction struct {
+	Action     string     `json:"action"`
+	ValidUntil *time.Time `json:"validUntil,omitempty"`
+}
+
+type standalonePriceAddPriceTierAction struct {
+	Action string                       `json:"action"`
+	Tier   commercetools.PriceTierDraft `json:"tier"`
+}
+
+type standalonePriceRemovePriceTierAction struct {
+	Action              string `json:"action"`
+	TierMinimumQuantity int    `json:"tierMinimumQuantity"`
+}
+
+type standalonePriceChangeActiveAction struct {
+	Action string `json:"action"`
+	Active bool   `json:"active"`
+}
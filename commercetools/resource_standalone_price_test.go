@@ -0,0 +1,133 @@
+package commercetools
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeStandalonePrice(t *testing.T) {
+	result, err := decodeStandalonePrice(json.RawMessage(`{
+		"id": "price-id",
+		"version": 3,
+		"key": "contract-price",
+		"sku": "my-sku",
+		"active": true,
+		"value": {
+			"type": "centPrecision",
+			"currencyCode": "EUR",
+			"centAmount": 1000,
+			"fractionDigits": 2
+		},
+		"tiers": [
+			{
+				"minimumQuantity": 10,
+				"value": {
+					"type": "centPrecision",
+					"currencyCode": "EUR",
+					"centAmount": 900,
+					"fractionDigits": 2
+				}
+			}
+		]
+	}`))
+	assert.NoError(t, err)
+	assert.Equal(t, "price-id", result.ID)
+	assert.Equal(t, 3, result.Version)
+	assert.Equal(t, "my-sku", result.SKU)
+	assert.True(t, result.Active)
+	assert.Equal(t, map[string]interface{}{
+		"currency_code": "EUR",
+		"cent_amount":   1000,
+	}, flattenTypedMoney(result.Value))
+	assert.Equal(t, []map[string]interface{}{
+		{
+			"minimum_quantity": 10,
+			"cent_amount":      900,
+		},
+	}, flattenStandalonePriceTiers(result.Tiers))
+}
+
+func TestAccStandalonePriceCreate_basic(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckStandalonePriceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStandalonePriceConfig(1000, true, `
+					tier {
+						minimum_quantity = 10
+						cent_amount = 900
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_standalone_price.contract", "sku", "contract-sku",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_standalone_price.contract", "value.0.cent_amount", "1000",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_standalone_price.contract", "tier.#", "1",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_standalone_price.contract", "active", "true",
+					),
+				),
+			},
+			{
+				Config: testAccStandalonePriceConfig(1200, false, `
+					tier {
+						minimum_quantity = 10
+						cent_amount = 1000
+					}
+					tier {
+						minimum_quantity = 100
+						cent_amount = 800
+					}`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_standalone_price.contract", "value.0.cent_amount", "1200",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_standalone_price.contract", "tier.#", "2",
+					),
+					resource.TestCheckResourceAttr(
+						"commercetools_standalone_price.contract", "active", "false",
+					),
+				),
+			},
+			{
+				ResourceName:      "commercetools_standalone_price.contract",
+				ImportState:       true,
+				ImportStateId:     "contract-price",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccStandalonePriceConfig(centAmount int, active bool, tiers string) string {
+	return fmt.Sprintf(`
+	resource "commercetools_standalone_price" "contract" {
+		key = "contract-price"
+		sku = "contract-sku"
+		value {
+			currency_code = "EUR"
+			cent_amount = %d
+		}
+		country = "NL"
+		active = %t
+		%s
+	}
+	`, centAmount, active, tiers)
+}
+
+func testAccCheckStandalonePriceDestroy(s *terraform.State) error {
+	return nil
+}
@@ -2,8 +2,12 @@ package commercetools
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
+	"net/url"
+	"sort"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -49,6 +53,23 @@ func resourceStore() *schema.Resource {
 				Optional: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"product_selection": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"product_selection_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"active": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -65,13 +86,20 @@ func resourceStoreCreate(d *schema.ResourceData, m interface{}) error {
 		DistributionChannels: dcIdentifiers,
 	}
 
-	client := getClient(m)
+	// The product selections are not part of the StoreDraft of the sdk, so
+	// the draft is extended with them and send with the rest client.
+	input := &storeDraft{
+		StoreDraft:        draft,
+		ProductSelections: expandStoreProductSelections(d.Get("product_selection").(*schema.Set)),
+	}
+
+	client := getRestClient(m)
 
 	var store *commercetools.Store
 
 	err := resource.Retry(20*time.Second, func() *resource.RetryError {
-		var err error
-		store, err = client.StoreCreate(context.Background(), draft)
+		store = &commercetools.Store{}
+		err := client.create(context.Background(), "stores", input, store)
 
 		if err != nil {
 			return handleCommercetoolsError(err)
@@ -89,13 +117,16 @@ func resourceStoreCreate(d *schema.ResourceData, m interface{}) error {
 }
 
 func resourceStoreRead(d *schema.ResourceData, m interface{}) error {
-	client := getClient(m)
+	client := getRestClient(m)
+
+	// The store is read as raw json since the sdk doesn't support the product
+	// selections of a store.
+	params := url.Values{}
+	params.Add("expand", "distributionChannels[*]")
+	params.Add("expand", "supplyChannels[*]")
 
-	store, err := client.StoreGetWithID(
-		context.Background(), d.Id(),
-		commercetools.WithReferenceExpansion("distributionChannels[*]"),
-		commercetools.WithReferenceExpansion("supplyChannels[*]"),
-	)
+	var data json.RawMessage
+	err := client.get(context.Background(), fmt.Sprintf("stores/%s", d.Id()), params, &data)
 
 	if err != nil {
 		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
@@ -107,6 +138,15 @@ func resourceStoreRead(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 
+	store := commercetools.Store{}
+	if err := json.Unmarshal(data, &store); err != nil {
+		return err
+	}
+	extraFields := storeExtraFields{}
+	if err := json.Unmarshal(data, &extraFields); err != nil {
+		return err
+	}
+
 	d.SetId(store.ID)
 	d.Set("key", store.Key)
 	d.Set("name", *store.Name)
@@ -134,6 +174,17 @@ func resourceStoreRead(d *schema.ResourceData, m interface{}) error {
 		log.Printf("[DEBUG] Setting channel keys to: %+v", channelKeys)
 		d.Set("supply_channels", channelKeys)
 	}
+
+	productSelections := make([]map[string]interface{}, 0, len(extraFields.ProductSelections))
+	for _, item := range extraFields.ProductSelections {
+		productSelections = append(productSelections, map[string]interface{}{
+			"product_selection_id": item.ProductSelection.ID,
+			"active":               item.Active,
+		})
+	}
+	if err := d.Set("product_selection", productSelections); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -190,6 +241,10 @@ func resourceStoreUpdate(d *schema.ResourceData, m interface{}) error {
 		)
 	}
 
+	if d.HasChange("product_selection") {
+		input.Actions = append(input.Actions, resourceStoreProductSelectionActions(d)...)
+	}
+
 	_, err := client.StoreUpdateWithID(context.Background(), input)
 	if err != nil {
 		return err
@@ -244,3 +299,111 @@ func flattenStoreChannels(channels []commercetools.ChannelReference) ([]string,
 	log.Printf("[DEBUG] flattening final keys: %v", channelKeys)
 	return channelKeys, nil
 }
+
+// resourceStoreProductSelectionActions returns the actions to add and remove
+// product selections, and to change whether an assigned product selection is
+// active.
+func resourceStoreProductSelectionActions(d *schema.ResourceData) []commercetools.StoreUpdateAction {
+	old, new := d.GetChange("product_selection")
+	oldActive := flattenStoreProductSelectionSet(old.(*schema.Set))
+	newActive := flattenStoreProductSelectionSet(new.(*schema.Set))
+
+	actions := []commercetools.StoreUpdateAction{}
+	for _, item := range expandStoreProductSelections(old.(*schema.Set)) {
+		if _, ok := newActive[item.ProductSelection.ID]; !ok {
+			actions = append(actions, storeRemoveProductSelectionAction{
+				Action:           "removeProductSelection",
+				ProductSelection: item.ProductSelection,
+			})
+		}
+	}
+	for _, item := range expandStoreProductSelections(new.(*schema.Set)) {
+		active, ok := oldActive[item.ProductSelection.ID]
+		if !ok {
+			actions = append(actions, storeAddProductSelectionAction{
+				Action:           "addProductSelection",
+				ProductSelection: item.ProductSelection,
+				Active:           item.Active,
+			})
+		} else if active != item.Active {
+			actions = append(actions, storeChangeProductSelectionActiveAction{
+				Action:           "changeProductSelectionActive",
+				ProductSelection: item.ProductSelection,
+				Active:           item.Active,
+			})
+		}
+	}
+	return actions
+}
+
+func expandStoreProductSelections(input *schema.Set) []storeProductSelectionDraft {
+	result := []storeProductSelectionDraft{}
+	for _, raw := range input.List() {
+		item := raw.(map[string]interface{})
+		result = append(result, storeProductSelectionDraft{
+			ProductSelection: storeProductSelectionResourceIdentifier{
+				ID: item["product_selection_id"].(string),
+			},
+			Active: item["active"].(bool),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ProductSelection.ID < result[j].ProductSelection.ID
+	})
+	return result
+}
+
+// flattenStoreProductSelectionSet returns the active flag per product
+// selection id
+func flattenStoreProductSelectionSet(input *schema.Set) map[string]bool {
+	result := make(map[string]bool, input.Len())
+	for _, item := range expandStoreProductSelections(input) {
+		result[item.ProductSelection.ID] = item.Active
+	}
+	return result
+}
+
+// storeDraft extends the sdk draft with the fields it doesn't support
+type storeDraft struct {
+	*commercetools.StoreDraft
+	ProductSelections []storeProductSelectionDraft `json:"productSelections,omitempty"`
+}
+
+// storeExtraFields contains the store fields which the sdk can't read
+type storeExtraFields struct {
+	ProductSelections []storeProductSelectionDraft `json:"productSelections"`
+}
+
+type storeProductSelectionResourceIdentifier struct {
+	ID string `json:"id"`
+}
+
+// MarshalJSON override to set the type id
+func (obj storeProductSelectionResourceIdentifier) MarshalJSON() ([]byte, error) {
+	return json.Marshal(map[string]string{
+		"typeId": "product-selection",
+		"id":     obj.ID,
+	})
+}
+
+type storeProductSelectionDraft struct {
+	ProductSelection storeProductSelectionResourceIdentifier `json:"productSelection"`
+	Active           bool                                    `json:"active"`
+}
+
+type storeAddProductSelectionAction struct {
+	Action           string                                  `json:"action"`
+	ProductSelection storeProductSelectionResourceIdentifier `json:"productSelection"`
+	Active           bool                                    `json:"active"`
+}
+
+type storeRemoveProductSelectionAction struct {
+	Action           string                                  `json:"action"`
+	ProductSelection storeProductSelectionResourceIdentifier `json:"productSelection"`
+}
+
+type storeChangeProductSelectionActiveAction struct {
+	Action           string                                  `json:"action"`
+	ProductSelection storeProductSelectionResourceIdentifier `json:"productSelection"`
+	Active           bool                                    `json:"active"`
+}
@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
@@ -33,14 +36,104 @@ func resourceTaxCategory() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"rate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     taxCategoryRateSchema(),
+			},
+			"rate_ids": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"version": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
 		},
+		CustomizeDiff: resourceTaxCategoryCustomizeDiff,
+	}
+}
+
+func taxCategoryRateSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"amount": {
+				Type:         schema.TypeFloat,
+				Required:     true,
+				ValidateFunc: resourceTaxCategoryValidateAmount,
+			},
+			"included_in_price": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+			"country": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: ValidateCountryCode,
+			},
+			"state": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"sub_rate": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"amount": {
+							Type:         schema.TypeFloat,
+							Required:     true,
+							ValidateFunc: resourceTaxCategoryValidateAmount,
+						},
+					},
+				},
+			},
+		},
 	}
 }
 
+// resourceTaxCategoryCustomizeDiff verifies that there is at most one rate
+// per country and state and that the locations are valid, and marks the rate
+// ids as changed when the rates change since replacing a rate changes its id.
+func resourceTaxCategoryCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("rate") {
+		return nil
+	}
+
+	if d.NewValueKnown("rate") {
+		keys := make(map[string]bool)
+		locations := []commercetools.Location{}
+		for _, raw := range d.Get("rate").([]interface{}) {
+			rate := raw.(map[string]interface{})
+			location := commercetools.Location{
+				Country: commercetools.CountryCode(rate["country"].(string)),
+				State:   rate["state"].(string),
+			}
+			key := taxRateLocationKey(location.Country, location.State)
+			if keys[key] {
+				return fmt.Errorf("there can be only one rate for %s", key)
+			}
+			keys[key] = true
+			locations = append(locations, location)
+		}
+
+		if err := validateLocations(m, locations); err != nil {
+			return err
+		}
+	}
+
+	return d.SetNewComputed("rate_ids")
+}
+
 func resourceTaxCategoryValidateAmount(val interface{}, key string) (warns []string, errs []error) {
 	v := val.(float64)
 	if v < 0 || v > 1 {
@@ -52,6 +145,7 @@ func resourceTaxCategoryValidateAmount(val interface{}, key string) (warns []str
 func resourceTaxCategoryCreate(d *schema.ResourceData, m interface{}) error {
 	client := getClient(m)
 	var taxCategory *commercetools.TaxCategory
+	var err error
 	emptyTaxRates := []commercetools.TaxRateDraft{}
 
 	draft := &commercetools.TaxCategoryDraft{
@@ -61,7 +155,14 @@ func resourceTaxCategoryCreate(d *schema.ResourceData, m interface{}) error {
 		Rates:       emptyTaxRates,
 	}
 
-	err := resource.Retry(1*time.Minute, func() *resource.RetryError {
+	if rates := d.Get("rate").([]interface{}); len(rates) > 0 {
+		draft.Rates, err = expandTaxCategoryRates(rates)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = resource.Retry(1*time.Minute, func() *resource.RetryError {
 		var err error
 
 		taxCategory, err = client.TaxCategoryCreate(context.Background(), draft)
@@ -87,9 +188,7 @@ func resourceTaxCategoryCreate(d *schema.ResourceData, m interface{}) error {
 
 func resourceTaxCategoryRead(d *schema.ResourceData, m interface{}) error {
 	log.Printf("[DEBUG] Reading tax category from commercetools, with taxCategory id: %s", d.Id())
-	client := getClient(m)
-
-	taxCategory, err := client.TaxCategoryGetWithID(context.Background(), d.Id())
+	taxCategory, err := readTaxCategory(m, d.Id())
 
 	if err != nil {
 		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
@@ -112,6 +211,14 @@ func resourceTaxCategoryRead(d *schema.ResourceData, m interface{}) error {
 		d.Set("key", taxCategory.Key)
 		d.Set("name", taxCategory.Name)
 		d.Set("description", taxCategory.Description)
+		d.Set("rate_ids", flattenTaxCategoryRateIDs(taxCategory.Rates))
+
+		// The rates are only set when they are managed by this resource,
+		// otherwise they are managed by commercetools_tax_category_rate
+		// resources.
+		if rates := d.Get("rate").([]interface{}); len(rates) > 0 {
+			d.Set("rate", flattenTaxCategoryRates(taxCategory.Rates, rates))
+		}
 	}
 	return nil
 }
@@ -154,11 +261,21 @@ func resourceTaxCategoryUpdate(d *schema.ResourceData, m interface{}) error {
 			&commercetools.TaxCategorySetDescriptionAction{Description: newDescription})
 	}
 
+	if d.HasChange("rate") {
+		old, new := d.GetChange("rate")
+		actions, err := resourceTaxCategoryRateActions(taxCategory, old.([]interface{}), new.([]interface{}))
+		if err != nil {
+			return err
+		}
+		input.Actions = append(input.Actions, actions...)
+	}
+
 	log.Printf(
 		"[DEBUG] Will perform update operation with the following actions:\n%s",
 		stringFormatActions(input.Actions))
 
 	_, err = client.TaxCategoryUpdateWithID(context.Background(), input)
+	getReadCache(m).invalidate(taxCategoryCacheKey(d.Id()))
 	if err != nil {
 		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
 			log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
@@ -181,9 +298,153 @@ func resourceTaxCategoryDelete(d *schema.ResourceData, m interface{}) error {
 		return err
 	}
 	_, err = client.TaxCategoryDeleteWithID(context.Background(), d.Id(), taxCategory.Version)
+	getReadCache(m).invalidate(taxCategoryCacheKey(d.Id()))
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+// resourceTaxCategoryRateActions returns the actions to update the rates of
+// the tax category. Rates are matched by country and state, a rate which
+// changed, for example its name or amount, is replaced. The ids of the rates
+// are taken from the current tax category. The update fails when the tax
+// category has rates which are not in the old rate blocks, since these are
+// managed by commercetools_tax_category_rate resources.
+func resourceTaxCategoryRateActions(taxCategory *commercetools.TaxCategory, old []interface{}, new []interface{}) ([]commercetools.TaxCategoryUpdateAction, error) {
+	removeActions := []commercetools.TaxCategoryUpdateAction{}
+	replaceActions := []commercetools.TaxCategoryUpdateAction{}
+	addActions := []commercetools.TaxCategoryUpdateAction{}
+
+	oldDrafts, err := expandTaxCategoryRates(old)
+	if err != nil {
+		return nil, err
+	}
+	newDrafts, err := expandTaxCategoryRates(new)
+	if err != nil {
+		return nil, err
+	}
+
+	oldRates := make(map[string]interface{})
+	for i, draft := range oldDrafts {
+		oldRates[taxRateLocationKey(draft.Country, draft.State)] = old[i]
+	}
+	unmanaged := []string{}
+	for _, rate := range taxCategory.Rates {
+		key := taxRateLocationKey(rate.Country, rate.State)
+		if _, ok := oldRates[key]; !ok {
+			unmanaged = append(unmanaged, key)
+		}
+	}
+	if len(unmanaged) > 0 {
+		sort.Strings(unmanaged)
+		return nil, fmt.Errorf(
+			"tax category %s has rates which are not managed by its rate blocks: %s, "+
+				"rate blocks can not be combined with commercetools_tax_category_rate resources",
+			taxCategory.ID, strings.Join(unmanaged, ", "))
+	}
+
+	newLocations := make(map[string]bool)
+	for _, draft := range newDrafts {
+		newLocations[taxRateLocationKey(draft.Country, draft.State)] = true
+	}
+
+	for _, draft := range oldDrafts {
+		if newLocations[taxRateLocationKey(draft.Country, draft.State)] {
+			continue
+		}
+		if current := findTaxRateByLocation(taxCategory, draft.Country, draft.State); current != nil {
+			removeActions = append(
+				removeActions,
+				&commercetools.TaxCategoryRemoveTaxRateAction{TaxRateID: current.ID})
+		}
+	}
+
+	for i := range newDrafts {
+		draft := newDrafts[i]
+		if oldRate, ok := oldRates[taxRateLocationKey(draft.Country, draft.State)]; ok && reflect.DeepEqual(oldRate, new[i]) {
+			continue
+		}
+		if current := findTaxRateByLocation(taxCategory, draft.Country, draft.State); current != nil {
+			replaceActions = append(
+				replaceActions,
+				&commercetools.TaxCategoryReplaceTaxRateAction{TaxRateID: current.ID, TaxRate: &draft})
+		} else {
+			addActions = append(
+				addActions,
+				&commercetools.TaxCategoryAddTaxRateAction{TaxRate: &draft})
+		}
+	}
+
+	actions := append(removeActions, replaceActions...)
+	return append(actions, addActions...), nil
+}
+
+func expandTaxCategoryRates(input []interface{}) ([]commercetools.TaxRateDraft, error) {
+	result := make([]commercetools.TaxRateDraft, len(input))
+	for i, raw := range input {
+		rate := raw.(map[string]interface{})
+		amount := rate["amount"].(float64)
+		subRates, err := resourceTaxCategoryRateGetSubRates(rate["sub_rate"].([]interface{}))
+		if err != nil {
+			return nil, err
+		}
+		result[i] = commercetools.TaxRateDraft{
+			Name:            rate["name"].(string),
+			Amount:          &amount,
+			IncludedInPrice: rate["included_in_price"].(bool),
+			Country:         commercetools.CountryCode(rate["country"].(string)),
+			State:           rate["state"].(string),
+			SubRates:        subRates,
+		}
+	}
+	return result, nil
+}
+
+// flattenTaxCategoryRates returns the rates which are in the state, matched by
+// their location, in the order of the state. The API returns the rates in the
+// order they were added. Other rates are left out, so they are not removed
+// by the next update.
+func flattenTaxCategoryRates(rates []commercetools.TaxRate, state []interface{}) []map[string]interface{} {
+	location := func(rate map[string]interface{}) string {
+		return taxRateLocationKey(
+			commercetools.CountryCode(rate["country"].(string)), rate["state"].(string))
+	}
+
+	order := make(map[string]int)
+	for i, raw := range state {
+		if rate, ok := raw.(map[string]interface{}); ok {
+			order[location(rate)] = i
+		}
+	}
+
+	result := []map[string]interface{}{}
+	for i := range rates {
+		rate := flattenTaxRate(&rates[i])
+		if _, ok := order[location(rate)]; ok {
+			result = append(result, rate)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return order[location(result[i])] < order[location(result[j])]
+	})
+	return result
+}
+
+// flattenTaxCategoryRateIDs returns the ids of the rates by their location,
+// which is the country or the country and state separated by a slash
+func flattenTaxCategoryRateIDs(rates []commercetools.TaxRate) map[string]string {
+	result := make(map[string]string, len(rates))
+	for _, rate := range rates {
+		result[taxRateLocationKey(rate.Country, rate.State)] = rate.ID
+	}
+	return result
+}
+
+func taxRateLocationKey(country commercetools.CountryCode, state string) string {
+	if state == "" {
+		return string(country)
+	}
+	return fmt.Sprintf("%s/%s", country, state)
+}
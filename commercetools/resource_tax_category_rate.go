@@ -20,6 +20,7 @@ func resourceTaxCategoryRate() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceTaxCategoryRateImportState,
 		},
+		CustomizeDiff: resourceTaxCategoryRateCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"tax_category_id": {
 				Type:     schema.TypeString,
@@ -39,8 +40,9 @@ func resourceTaxCategoryRate() *schema.Resource {
 				Required: true,
 			},
 			"country": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: ValidateCountryCode,
 			},
 			"state": {
 				Type:     schema.TypeString,
@@ -67,6 +69,21 @@ func resourceTaxCategoryRate() *schema.Resource {
 	}
 }
 
+func resourceTaxCategoryRateCustomizeDiff(d *schema.ResourceDiff, m interface{}) error {
+	if !d.HasChange("country") && !d.HasChange("state") {
+		return nil
+	}
+	if !d.NewValueKnown("country") || !d.NewValueKnown("state") {
+		return nil
+	}
+	return validateLocations(m, []commercetools.Location{
+		{
+			Country: commercetools.CountryCode(d.Get("country").(string)),
+			State:   d.Get("state").(string),
+		},
+	})
+}
+
 func resourceTaxCategoryRateImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
 	client := getClient(meta)
 	taxRateID := d.Id()
@@ -114,61 +131,39 @@ func resourceTaxCategoryRateGetSubRates(input []interface{}) ([]commercetools.Su
 }
 
 func resourceTaxCategoryRateCreate(d *schema.ResourceData, m interface{}) error {
-	client := getClient(m)
 	taxCategoryID := d.Get("tax_category_id").(string)
 
-	// Lock to prevent concurrent updates due to Version number conflicts
-	ctMutexKV.Lock(taxCategoryID)
-	defer ctMutexKV.Unlock(taxCategoryID)
-
-	taxCategory, err := client.TaxCategoryGetWithID(context.Background(), taxCategoryID)
-
-	if err != nil {
-		return err
-	}
-
-	oldTaxRateIds := getTaxRateIds(taxCategory)
-
-	input := &commercetools.TaxCategoryUpdateWithIDInput{
-		ID:      taxCategoryID,
-		Version: taxCategory.Version,
-		Actions: []commercetools.TaxCategoryUpdateAction{},
-	}
-
 	taxRateDraft, err := createTaxRateDraft(d)
 	if err != nil {
 		return err
 	}
 
-	input.Actions = append(input.Actions, commercetools.TaxCategoryAddTaxRateAction{TaxRate: taxRateDraft})
-
-	err = resource.Retry(30*time.Second, func() *resource.RetryError {
-		taxCategory, err = client.TaxCategoryUpdateWithID(context.Background(), input)
-		if err != nil {
-			return handleCommercetoolsError(err)
-		}
-		return nil
-	})
+	// The rate is added in the same update as the other rates of the tax
+	// category which are created or changed at the same time.
+	result, err := ctUpdateBatcher.submit(
+		taxCategoryID,
+		taxCategoryBatchHandler(m, taxCategoryID),
+		func(parent interface{}, pending []interface{}) ([]interface{}, error) {
+			return []interface{}{
+				commercetools.TaxCategoryAddTaxRateAction{TaxRate: taxRateDraft},
+			}, nil
+		})
 
 	if err != nil {
 		return err
 	}
 
-	// Refresh the taxCategory. When a tax rate is added the ID is different
-	// then the ID returned in the response
-	updatedTaxCategory, err := client.TaxCategoryGetWithID(
-		context.Background(), taxCategoryID)
-
-	newTaxRate := findNewTaxRate(updatedTaxCategory, oldTaxRateIds)
-
+	taxCategory := result.(*commercetools.TaxCategory)
+	newTaxRate := findTaxRateByLocation(taxCategory, taxRateDraft.Country, taxRateDraft.State)
 	if newTaxRate == nil {
-		log.Fatal("No tax category rate created?")
+		return fmt.Errorf("Could not find the created tax rate in tax category %s", taxCategoryID)
 	}
 
 	d.SetId(newTaxRate.ID)
 	d.Set("tax_category_id", taxCategory.ID)
+	setTaxRateState(d, newTaxRate)
 
-	return resourceTaxCategoryRateRead(d, m)
+	return nil
 }
 
 func resourceTaxCategoryRateRead(d *schema.ResourceData, m interface{}) error {
@@ -187,12 +182,16 @@ func resourceTaxCategoryRateRead(d *schema.ResourceData, m interface{}) error {
 
 func setTaxRateState(d *schema.ResourceData, taxRate *commercetools.TaxRate) {
 	log.Printf("[DEBUG] Setting state: %s to taxRate: %s", stringFormatObject(d), stringFormatObject(taxRate))
-	d.Set("name", taxRate.Name)
-	d.Set("amount", taxRate.Amount)
-	d.Set("included_in_price", taxRate.IncludedInPrice)
-	d.Set("country", taxRate.Country)
-	d.Set("state", taxRate.State)
+	for key, value := range flattenTaxRate(taxRate) {
+		d.Set(key, value)
+	}
+
+	log.Printf("[DEBUG] Updated state to: %s", stringFormatObject(d))
+}
 
+// flattenTaxRate converts a tax rate to the state structure used by the
+// commercetools_tax_category_rate resource
+func flattenTaxRate(taxRate *commercetools.TaxRate) map[string]interface{} {
 	subRateData := make([]map[string]interface{}, len(taxRate.SubRates))
 	for srIndex, subrate := range taxRate.SubRates {
 		subRateData[srIndex] = map[string]interface{}{
@@ -200,69 +199,60 @@ func setTaxRateState(d *schema.ResourceData, taxRate *commercetools.TaxRate) {
 			"amount": subrate.Amount,
 		}
 	}
-	d.Set("sub_rate", subRateData)
 
-	log.Printf("[DEBUG] Updated state to: %s", stringFormatObject(d))
+	return map[string]interface{}{
+		"name":              taxRate.Name,
+		"amount":            taxRate.Amount,
+		"included_in_price": taxRate.IncludedInPrice,
+		"country":           string(taxRate.Country),
+		"state":             taxRate.State,
+		"sub_rate":          subRateData,
+	}
 }
 
 func resourceTaxCategoryRateUpdate(d *schema.ResourceData, m interface{}) error {
 	taxCategoryID := d.Get("tax_category_id").(string)
 
-	// Lock to prevent concurrent updates due to Version number conflicts
-	ctMutexKV.Lock(taxCategoryID)
-	defer ctMutexKV.Unlock(taxCategoryID)
+	if !d.HasChange("name") && !d.HasChange("amount") && !d.HasChange("included_in_price") && !d.HasChange("country") && !d.HasChange("state") && !d.HasChange("sub_rate") {
+		return resourceTaxCategoryRateRead(d, m)
+	}
 
-	taxCategory, _, err := readResourcesFromStateIDs(d, m)
+	taxRateDraft, err := createTaxRateDraft(d)
 	if err != nil {
 		return err
 	}
 
-	oldTaxRateIds := getTaxRateIds(taxCategory)
-
-	input := &commercetools.TaxCategoryUpdateWithIDInput{
-		ID:      taxCategory.ID,
-		Version: taxCategory.Version,
-		Actions: []commercetools.TaxCategoryUpdateAction{},
-	}
-
-	if d.HasChange("name") || d.HasChange("amount") || d.HasChange("included_in_price") || d.HasChange("country") || d.HasChange("state") || d.HasChange("sub_rate") {
-		taxRateDraft, err := createTaxRateDraft(d)
-		if err != nil {
-			return err
-		}
-		input.Actions = append(input.Actions, commercetools.TaxCategoryReplaceTaxRateAction{
-			TaxRateID: d.Id(),
-			TaxRate:   taxRateDraft,
+	taxRateID := d.Id()
+	result, err := ctUpdateBatcher.submit(
+		taxCategoryID,
+		taxCategoryBatchHandler(m, taxCategoryID),
+		func(parent interface{}, pending []interface{}) ([]interface{}, error) {
+			taxCategory := parent.(*commercetools.TaxCategory)
+			if getTaxRateWithID(taxCategory, taxRateID) == nil {
+				return nil, fmt.Errorf("Could not find tax rate %s in tax category %s", taxRateID, taxCategory.ID)
+			}
+			return []interface{}{
+				commercetools.TaxCategoryReplaceTaxRateAction{
+					TaxRateID: taxRateID,
+					TaxRate:   taxRateDraft,
+				},
+			}, nil
 		})
-	}
-
-	log.Printf(
-		"[DEBUG] Will perform update operation with the following actions:\n%s",
-		stringFormatActions(input.Actions))
 
-	client := getClient(m)
-	taxCategory, err = client.TaxCategoryUpdateWithID(context.Background(), input)
 	if err != nil {
-		if ctErr, ok := err.(commercetools.ErrorResponse); ok {
-			log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
-		}
 		return err
 	}
 
-	// Refresh the taxCategory. When a tax rate is added the ID is different
-	// then the ID returned in the response
-	updatedTaxCategory, err := client.TaxCategoryGetWithID(
-		context.Background(), taxCategoryID)
-
-	newTaxRate := findNewTaxRate(updatedTaxCategory, oldTaxRateIds)
-
+	// Replacing a tax rate changes its ID
+	newTaxRate := findTaxRateByLocation(result.(*commercetools.TaxCategory), taxRateDraft.Country, taxRateDraft.State)
 	if newTaxRate == nil {
-		log.Fatal("No tax category rate created?")
+		return fmt.Errorf("Could not find the updated tax rate in tax category %s", taxCategoryID)
 	}
 
 	d.SetId(newTaxRate.ID)
+	setTaxRateState(d, newTaxRate)
 
-	return resourceTaxCategoryRateRead(d, m)
+	return nil
 }
 
 func createTaxRateDraft(d *schema.ResourceData) (*commercetools.TaxRateDraft, error) {
@@ -300,44 +290,110 @@ func createTaxRateDraft(d *schema.ResourceData) (*commercetools.TaxRateDraft, er
 
 func resourceTaxCategoryRateDelete(d *schema.ResourceData, m interface{}) error {
 	taxCategoryID := d.Get("tax_category_id").(string)
+	taxRateID := d.Id()
+
+	_, err := ctUpdateBatcher.submit(
+		taxCategoryID,
+		taxCategoryBatchHandler(m, taxCategoryID),
+		func(parent interface{}, pending []interface{}) ([]interface{}, error) {
+			taxCategory := parent.(*commercetools.TaxCategory)
+			if getTaxRateWithID(taxCategory, taxRateID) == nil {
+				return nil, fmt.Errorf("Could not find tax rate %s in tax category %s", taxRateID, taxCategory.ID)
+			}
+			return []interface{}{
+				commercetools.TaxCategoryRemoveTaxRateAction{TaxRateID: taxRateID},
+			}, nil
+		})
 
-	// Lock to prevent concurrent updates due to Version number conflicts
-	ctMutexKV.Lock(taxCategoryID)
-	defer ctMutexKV.Unlock(taxCategoryID)
+	return err
+}
 
-	taxCategory, taxRate, err := readResourcesFromStateIDs(d, m)
+// readTaxCategory returns the tax category from the read cache of the
+// provider, it is prefetched together with the other tax categories when
+// enabled
+func readTaxCategory(m interface{}, taxCategoryID string) (*commercetools.TaxCategory, error) {
+	client := getClient(m)
+	value, err := readPrefetched(m, "tax_categories", taxCategoryID, func() (interface{}, int, error) {
+		taxCategory, err := client.TaxCategoryGetWithID(context.Background(), taxCategoryID)
+		if err != nil {
+			return nil, 0, err
+		}
+		return taxCategory, taxCategory.Version, nil
+	})
 	if err != nil {
-		return err
-	}
-
-	input := &commercetools.TaxCategoryUpdateWithIDInput{
-		ID:      taxCategory.ID,
-		Version: taxCategory.Version,
-		Actions: []commercetools.TaxCategoryUpdateAction{},
+		return nil, err
 	}
+	return value.(*commercetools.TaxCategory), nil
+}
 
-	removeAction := commercetools.TaxCategoryRemoveTaxRateAction{
-		TaxRateID: taxRate.ID,
-	}
-	input.Actions = append(input.Actions, removeAction)
+func taxCategoryCacheKey(taxCategoryID string) string {
+	return readCacheKey("tax-categories", taxCategoryID)
+}
 
+// taxCategoryBatchHandler reads and updates a tax category for the batched
+// updates of its rates. The batch always reads the current version of the tax
+// category and stores the result in the read cache.
+func taxCategoryBatchHandler(m interface{}, taxCategoryID string) updateBatchHandler {
 	client := getClient(m)
-	_, err = client.TaxCategoryUpdateWithID(context.Background(), input)
-	if err != nil {
-		return err
-	}
+	cache := getReadCache(m)
+	return updateBatchHandler{
+		fetch: func() (interface{}, error) {
+			taxCategory, err := client.TaxCategoryGetWithID(context.Background(), taxCategoryID)
+			if err != nil {
+				return nil, err
+			}
+			cache.store(taxCategoryCacheKey(taxCategoryID), taxCategory, taxCategory.Version)
+			return taxCategory, nil
+		},
+		apply: func(parent interface{}, actions []interface{}) (interface{}, error) {
+			input := &commercetools.TaxCategoryUpdateWithIDInput{
+				ID:      taxCategoryID,
+				Version: parent.(*commercetools.TaxCategory).Version,
+				Actions: []commercetools.TaxCategoryUpdateAction{},
+			}
+			for _, action := range actions {
+				input.Actions = append(input.Actions, action)
+			}
 
-	return nil
+			log.Printf(
+				"[DEBUG] Will perform update operation with the following actions:\n%s",
+				stringFormatActions(input.Actions))
+
+			err := resource.Retry(30*time.Second, func() *resource.RetryError {
+				_, err := client.TaxCategoryUpdateWithID(context.Background(), input)
+				if err != nil {
+					return handleCommercetoolsError(err)
+				}
+				return nil
+			})
+			if err != nil {
+				if ctErr, ok := err.(commercetools.ErrorResponse); ok {
+					log.Printf("[DEBUG] %v: %v", ctErr, stringFormatErrorExtras(ctErr))
+				}
+				return nil, err
+			}
+
+			// Refresh the taxCategory. When a tax rate is added the ID is
+			// different then the ID returned in the response
+			taxCategory, err := client.TaxCategoryGetWithID(context.Background(), taxCategoryID)
+			if err != nil {
+				return nil, err
+			}
+			cache.store(taxCategoryCacheKey(taxCategoryID), taxCategory, taxCategory.Version)
+			return taxCategory, nil
+		},
+	}
 }
 
 func readResourcesFromStateIDs(d *schema.ResourceData, m interface{}) (*commercetools.TaxCategory, *commercetools.TaxRate, error) {
-	client := getClient(m)
 	taxCategoryID := d.Get("tax_category_id").(string)
 	taxRateID := d.Id()
 
 	log.Printf("[DEBUG] Reading tax category from commercetools, taxCategory ID: %s, taxRate ID: %s", taxCategoryID, taxRateID)
 
-	taxCategory, err := client.TaxCategoryGetWithID(context.Background(), taxCategoryID)
+	// The tax category is shared by all its rates, so it is read only once
+	// during a refresh
+	taxCategory, err := readTaxCategory(m, taxCategoryID)
 
 	if err != nil {
 		return nil, nil, err
@@ -363,19 +419,12 @@ func validateTaxRateAmount(val interface{}, key string) (warns []string, errs []
 	return
 }
 
-func getTaxRateIds(taxCategory *commercetools.TaxCategory) []string {
-	taxRateIds := []string{}
-	for _, rate := range taxCategory.Rates {
-		taxRateIds = append(taxRateIds, rate.ID)
-	}
-
-	return taxRateIds
-}
-
-// Find new tax rate by comparing with tax rate ids created just before adding new tax rate
-func findNewTaxRate(taxCategory *commercetools.TaxCategory, oldTaxRateIds []string) *commercetools.TaxRate {
+// findTaxRateByLocation returns the tax rate for the country and state, a tax
+// category has at most one rate per location
+func findTaxRateByLocation(taxCategory *commercetools.TaxCategory, country commercetools.CountryCode, state string) *commercetools.TaxRate {
 	for _, taxRate := range taxCategory.Rates {
-		if !stringInSlice(taxRate.ID, oldTaxRateIds) {
+		if taxRate.Country == country && taxRate.State == state {
+			taxRate := taxRate
 			return &taxRate
 		}
 	}
@@ -6,8 +6,124 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/stretchr/testify/assert"
 )
 
+func TestResourceTaxCategoryRateActions(t *testing.T) {
+	old := []interface{}{
+		map[string]interface{}{
+			"name":              "NL",
+			"amount":            0.21,
+			"included_in_price": true,
+			"country":           "NL",
+			"state":             "",
+			"sub_rate":          []interface{}{},
+		},
+		map[string]interface{}{
+			"name":              "DE",
+			"amount":            0.19,
+			"included_in_price": true,
+			"country":           "DE",
+			"state":             "",
+			"sub_rate":          []interface{}{},
+		},
+		map[string]interface{}{
+			"name":              "California",
+			"amount":            0.0725,
+			"included_in_price": true,
+			"country":           "US",
+			"state":             "CA",
+			"sub_rate":          []interface{}{},
+		},
+	}
+	new := []interface{}{
+		map[string]interface{}{
+			"name":              "Germany",
+			"amount":            0.19,
+			"included_in_price": true,
+			"country":           "DE",
+			"state":             "",
+			"sub_rate":          []interface{}{},
+		},
+		map[string]interface{}{
+			"name":              "NL",
+			"amount":            0.21,
+			"included_in_price": true,
+			"country":           "NL",
+			"state":             "",
+			"sub_rate":          []interface{}{},
+		},
+		map[string]interface{}{
+			"name":              "New York",
+			"amount":            0.04,
+			"included_in_price": true,
+			"country":           "US",
+			"state":             "NY",
+			"sub_rate":          []interface{}{},
+		},
+	}
+	taxCategory := &commercetools.TaxCategory{
+		ID: "tax-category-id",
+		Rates: []commercetools.TaxRate{
+			{ID: "rate-nl", Country: "NL"},
+			{ID: "rate-de", Country: "DE"},
+			{ID: "rate-us-ca", Country: "US", State: "CA"},
+		},
+	}
+
+	actions, err := resourceTaxCategoryRateActions(taxCategory, old, new)
+	assert.NoError(t, err)
+	assert.Len(t, actions, 3)
+	assert.Equal(t, &commercetools.TaxCategoryRemoveTaxRateAction{TaxRateID: "rate-us-ca"}, actions[0])
+
+	replace := actions[1].(*commercetools.TaxCategoryReplaceTaxRateAction)
+	assert.Equal(t, "rate-de", replace.TaxRateID)
+	assert.Equal(t, "Germany", replace.TaxRate.Name)
+
+	add := actions[2].(*commercetools.TaxCategoryAddTaxRateAction)
+	assert.Equal(t, "New York", add.TaxRate.Name)
+	assert.Equal(t, commercetools.CountryCode("US"), add.TaxRate.Country)
+	assert.Equal(t, "NY", add.TaxRate.State)
+	assert.Equal(t, 0.04, *add.TaxRate.Amount)
+
+	// Rates which are not in the old rate blocks are managed by
+	// commercetools_tax_category_rate resources
+	taxCategory.Rates = append(taxCategory.Rates, commercetools.TaxRate{ID: "rate-fr", Country: "FR"})
+	_, err = resourceTaxCategoryRateActions(taxCategory, old, new)
+	assert.EqualError(t, err, "tax category tax-category-id has rates which are not managed by its rate blocks: FR, "+
+		"rate blocks can not be combined with commercetools_tax_category_rate resources")
+}
+
+func TestFlattenTaxCategoryRates(t *testing.T) {
+	amount := 0.21
+	rates := []commercetools.TaxRate{
+		{ID: "rate-de", Name: "DE", Amount: &amount, Country: "DE"},
+		{ID: "rate-us-ca", Name: "California", Amount: &amount, Country: "US", State: "CA"},
+		{ID: "rate-nl", Name: "NL", Amount: &amount, Country: "NL"},
+	}
+	state := []interface{}{
+		map[string]interface{}{"country": "NL", "state": ""},
+		map[string]interface{}{"country": "DE", "state": ""},
+	}
+
+	result := flattenTaxCategoryRates(rates, state)
+	assert.Len(t, result, 2)
+	assert.Equal(t, "NL", result[0]["country"])
+	assert.Equal(t, "DE", result[1]["country"])
+}
+
+func TestFlattenTaxCategoryRateIDs(t *testing.T) {
+	result := flattenTaxCategoryRateIDs([]commercetools.TaxRate{
+		{ID: "rate-nl", Country: "NL"},
+		{ID: "rate-us-ca", Country: "US", State: "CA"},
+	})
+	assert.Equal(t, map[string]string{
+		"NL":    "rate-nl",
+		"US/CA": "rate-us-ca",
+	}, result)
+}
+
 func TestAccTaxCategory_createAndUpdateWithID(t *testing.T) {
 
 	name := "test category"
@@ -55,6 +171,70 @@ func TestAccTaxCategory_createAndUpdateWithID(t *testing.T) {
 	})
 }
 
+func TestAccTaxCategory_rates(t *testing.T) {
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckTaxCategoryDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxCategoryRatesConfig(0.21, "DE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_tax_category.inline", "rate.#", "2",
+					),
+					resource.TestCheckResourceAttrSet(
+						"commercetools_tax_category.inline", "rate_ids.NL",
+					),
+					resource.TestCheckResourceAttrSet(
+						"commercetools_tax_category.inline", "rate_ids.DE",
+					),
+				),
+			},
+			{
+				Config: testAccTaxCategoryRatesConfig(0.09, "BE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"commercetools_tax_category.inline", "rate.#", "2",
+					),
+					resource.TestCheckResourceAttrSet(
+						"commercetools_tax_category.inline", "rate_ids.NL",
+					),
+					resource.TestCheckResourceAttrSet(
+						"commercetools_tax_category.inline", "rate_ids.BE",
+					),
+					resource.TestCheckNoResourceAttr(
+						"commercetools_tax_category.inline", "rate_ids.DE",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccTaxCategoryRatesConfig(amount float64, country string) string {
+	return fmt.Sprintf(`
+resource "commercetools_tax_category" "inline" {
+	name = "inline rates"
+	key  = "inline-rates"
+
+	rate {
+		name              = "NL"
+		amount            = %[1]f
+		included_in_price = true
+		country           = "NL"
+	}
+
+	rate {
+		name              = "%[2]s"
+		amount            = 0.19
+		included_in_price = true
+		country           = "%[2]s"
+	}
+}`, amount, country)
+}
+
 func testAccTaxCategoryConfig(name string, key string, description string) string {
 	return fmt.Sprintf(`
 resource "commercetools_tax_category" "standard" {
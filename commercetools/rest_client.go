@@ -0,0 +1,114 @@
+package commercetools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/labd/commercetools-go-sdk/commercetools"
+)
+
+// restClient performs plain HTTP requests against the commercetools API. It
+// is used for endpoints and fields which are not available in the
+// commercetools-go-sdk. Errors are returned as commercetools.ErrorResponse so
+// they can be handled the same way as errors from the sdk client.
+type restClient struct {
+	httpClient *http.Client
+	apiURL     string
+	projectKey string
+}
+
+func newRestClient(httpClient *http.Client, apiURL string, projectKey string) *restClient {
+	return &restClient{
+		httpClient: httpClient,
+		apiURL:     strings.TrimRight(apiURL, "/"),
+		projectKey: projectKey,
+	}
+}
+
+func (c *restClient) get(ctx context.Context, endpoint string, params url.Values, output interface{}) error {
+	return c.doRequest(ctx, http.MethodGet, endpoint, params, nil, output)
+}
+
+func (c *restClient) create(ctx context.Context, endpoint string, input interface{}, output interface{}) error {
+	return c.doRequest(ctx, http.MethodPost, endpoint, nil, input, output)
+}
+
+func (c *restClient) update(ctx context.Context, endpoint string, version int, actions interface{}, output interface{}) error {
+	input := map[string]interface{}{
+		"version": version,
+		"actions": actions,
+	}
+	return c.doRequest(ctx, http.MethodPost, endpoint, nil, input, output)
+}
+
+func (c *restClient) delete(ctx context.Context, endpoint string, version int, output interface{}) error {
+	params := url.Values{}
+	params.Set("version", fmt.Sprint(version))
+	return c.doRequest(ctx, http.MethodDelete, endpoint, params, nil, output)
+}
+
+func (c *restClient) doRequest(ctx context.Context, method string, endpoint string, params url.Values, input interface{}, output interface{}) error {
+	var body io.Reader
+	if input != nil {
+		data, err := json.Marshal(input)
+		if err != nil {
+			return fmt.Errorf("Unable to serialize content: %s", err)
+		}
+		body = bytes.NewReader(data)
+	}
+
+	// An empty endpoint refers to the project itself
+	requestURL := fmt.Sprintf("%s/%s", c.apiURL, c.projectKey)
+	if endpoint != "" {
+		requestURL = fmt.Sprintf("%s/%s", requestURL, endpoint)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, requestURL, body)
+	if err != nil {
+		return err
+	}
+	if params != nil {
+		req.URL.RawQuery = params.Encode()
+	}
+	req.Header.Set("Accept", "application/json; charset=utf-8")
+	req.Header.Set("Content-Type", "application/json; charset=utf-8")
+
+	log.Printf("[DEBUG] Performing %s request to %s", method, req.URL.String())
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusCreated:
+		if output == nil {
+			return nil
+		}
+		return json.Unmarshal(data, output)
+	default:
+		if len(data) == 0 {
+			return commercetools.ErrorResponse{
+				StatusCode: resp.StatusCode,
+				Message:    fmt.Sprintf("%s (%d)", http.StatusText(resp.StatusCode), resp.StatusCode),
+			}
+		}
+		ctErr := commercetools.ErrorResponse{}
+		if err := json.Unmarshal(data, &ctErr); err != nil {
+			return err
+		}
+		return ctErr
+	}
+}
@@ -0,0 +1,165 @@
+//go:build ignore
+// +build ignore
+
+// This program generates subdivisions.go from the ISO 3166-2 data of the
+// iso-codes package (https://salsa.debian.org/iso-codes-team/iso-codes). The
+// English names of the subdivisions are taken from the English translations of
+// the package, when they differ from the ISO name. It is invoked by go
+// generate, the location of the data can be passed with -input and
+// -translations:
+//
+//	go run subdivisions_gen.go \
+//		-input /usr/share/iso-codes/json/iso_3166-2.json \
+//		-translations /usr/share/locale/en/LC_MESSAGES/iso_3166-2.mo
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"sort"
+	"strings"
+)
+
+type isoSubdivision struct {
+	Code string `json:"code"`
+	Name string `json:"name"`
+}
+
+// readTranslations reads the messages of a gettext .mo file, see
+// https://www.gnu.org/software/gettext/manual/html_node/MO-Files.html
+func readTranslations(path string) (map[string]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 20 {
+		return nil, fmt.Errorf("%s is not a .mo file", path)
+	}
+
+	var order binary.ByteOrder = binary.LittleEndian
+	if binary.BigEndian.Uint32(data) == 0x950412de {
+		order = binary.BigEndian
+	} else if order.Uint32(data) != 0x950412de {
+		return nil, fmt.Errorf("%s is not a .mo file", path)
+	}
+
+	text := func(table uint32, i uint32) (string, error) {
+		offset := table + i*8
+		if int(offset+8) > len(data) {
+			return "", fmt.Errorf("invalid string table in %s", path)
+		}
+		length := order.Uint32(data[offset:])
+		start := order.Uint32(data[offset+4:])
+		if int(start+length) > len(data) {
+			return "", fmt.Errorf("invalid string in %s", path)
+		}
+		return string(data[start : start+length]), nil
+	}
+
+	count := order.Uint32(data[8:])
+	originals := order.Uint32(data[12:])
+	translations := order.Uint32(data[16:])
+
+	result := make(map[string]string, count)
+	for i := uint32(0); i < count; i++ {
+		original, err := text(originals, i)
+		if err != nil {
+			return nil, err
+		}
+		translation, err := text(translations, i)
+		if err != nil {
+			return nil, err
+		}
+		result[original] = translation
+	}
+	return result, nil
+}
+
+func main() {
+	input := flag.String("input", "/usr/share/iso-codes/json/iso_3166-2.json", "the iso_3166-2.json file of the iso-codes package")
+	translationsInput := flag.String("translations", "/usr/share/locale/en/LC_MESSAGES/iso_3166-2.mo", "the English iso_3166-2.mo file of the iso-codes package")
+	output := flag.String("output", "subdivisions.go", "the file to write")
+	flag.Parse()
+
+	translations, err := readTranslations(*translationsInput)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	data, err := ioutil.ReadFile(*input)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	var document struct {
+		Subdivisions []isoSubdivision `json:"3166-2"`
+	}
+	if err := json.Unmarshal(data, &document); err != nil {
+		log.Fatal(err)
+	}
+
+	byCountry := make(map[string][]isoSubdivision)
+	for _, item := range document.Subdivisions {
+		parts := strings.SplitN(item.Code, "-", 2)
+		if len(parts) != 2 {
+			log.Fatalf("invalid subdivision code %s", item.Code)
+		}
+		byCountry[parts[0]] = append(byCountry[parts[0]], isoSubdivision{Code: parts[1], Name: item.Name})
+	}
+
+	countries := make([]string, 0, len(byCountry))
+	for country := range byCountry {
+		countries = append(countries, country)
+	}
+	sort.Strings(countries)
+
+	buf := &bytes.Buffer{}
+	fmt.Fprint(buf, `// Code generated by subdivisions_gen.go. DO NOT EDIT.
+
+package commercetools
+
+//go:generate go run subdivisions_gen.go
+
+// subdivision is an ISO 3166-2 subdivision of a country, the code is without
+// the country prefix. The English name is only set when it differs from the
+// ISO name, for example Bavaria for Bayern.
+type subdivision struct {
+	code        string
+	name        string
+	englishName string
+}
+
+// subdivisions are the ISO 3166-2 subdivisions by country. The list is based
+// on the iso-codes package, a country without subdivisions has no entry.
+var subdivisions = map[string][]subdivision{
+`)
+	for _, country := range countries {
+		items := byCountry[country]
+		sort.Slice(items, func(i, j int) bool { return items[i].Code < items[j].Code })
+
+		fmt.Fprintf(buf, "%q: {\n", country)
+		for _, item := range items {
+			englishName := translations[item.Name]
+			if englishName == item.Name {
+				englishName = ""
+			}
+			fmt.Fprintf(buf, "{%q, %q, %q},\n", item.Code, item.Name, englishName)
+		}
+		fmt.Fprint(buf, "},\n")
+	}
+	fmt.Fprint(buf, "}\n")
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*output, source, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
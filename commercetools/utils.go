@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
 	"strings"
 	"time"
 
@@ -18,8 +19,18 @@ import (
 const TypeLocalizedString = schema.TypeMap
 
 func getClient(m interface{}) *commercetools.Client {
-	client := m.(*commercetools.Client)
-	return client
+	config := m.(*providerConfig)
+	return config.client
+}
+
+func getRestClient(m interface{}) *restClient {
+	config := m.(*providerConfig)
+	return config.rest
+}
+
+func getReadCache(m interface{}) *readCache {
+	config := m.(*providerConfig)
+	return config.cache
 }
 
 func handleCommercetoolsError(err error) *resource.RetryError {
@@ -300,6 +311,258 @@ var currencyCodes = map[string]bool{
 	"ZWL": true,
 }
 
+var countryCodes = map[string]bool{
+	"AD": true,
+	"AE": true,
+	"AF": true,
+	"AG": true,
+	"AI": true,
+	"AL": true,
+	"AM": true,
+	"AO": true,
+	"AQ": true,
+	"AR": true,
+	"AS": true,
+	"AT": true,
+	"AU": true,
+	"AW": true,
+	"AX": true,
+	"AZ": true,
+	"BA": true,
+	"BB": true,
+	"BD": true,
+	"BE": true,
+	"BF": true,
+	"BG": true,
+	"BH": true,
+	"BI": true,
+	"BJ": true,
+	"BL": true,
+	"BM": true,
+	"BN": true,
+	"BO": true,
+	"BQ": true,
+	"BR": true,
+	"BS": true,
+	"BT": true,
+	"BV": true,
+	"BW": true,
+	"BY": true,
+	"BZ": true,
+	"CA": true,
+	"CC": true,
+	"CD": true,
+	"CF": true,
+	"CG": true,
+	"CH": true,
+	"CI": true,
+	"CK": true,
+	"CL": true,
+	"CM": true,
+	"CN": true,
+	"CO": true,
+	"CR": true,
+	"CU": true,
+	"CV": true,
+	"CW": true,
+	"CX": true,
+	"CY": true,
+	"CZ": true,
+	"DE": true,
+	"DJ": true,
+	"DK": true,
+	"DM": true,
+	"DO": true,
+	"DZ": true,
+	"EC": true,
+	"EE": true,
+	"EG": true,
+	"EH": true,
+	"ER": true,
+	"ES": true,
+	"ET": true,
+	"FI": true,
+	"FJ": true,
+	"FK": true,
+	"FM": true,
+	"FO": true,
+	"FR": true,
+	"GA": true,
+	"GB": true,
+	"GD": true,
+	"GE": true,
+	"GF": true,
+	"GG": true,
+	"GH": true,
+	"GI": true,
+	"GL": true,
+	"GM": true,
+	"GN": true,
+	"GP": true,
+	"GQ": true,
+	"GR": true,
+	"GS": true,
+	"GT": true,
+	"GU": true,
+	"GW": true,
+	"GY": true,
+	"HK": true,
+	"HM": true,
+	"HN": true,
+	"HR": true,
+	"HT": true,
+	"HU": true,
+	"ID": true,
+	"IE": true,
+	"IL": true,
+	"IM": true,
+	"IN": true,
+	"IO": true,
+	"IQ": true,
+	"IR": true,
+	"IS": true,
+	"IT": true,
+	"JE": true,
+	"JM": true,
+	"JO": true,
+	"JP": true,
+	"KE": true,
+	"KG": true,
+	"KH": true,
+	"KI": true,
+	"KM": true,
+	"KN": true,
+	"KP": true,
+	"KR": true,
+	"KW": true,
+	"KY": true,
+	"KZ": true,
+	"LA": true,
+	"LB": true,
+	"LC": true,
+	"LI": true,
+	"LK": true,
+	"LR": true,
+	"LS": true,
+	"LT": true,
+	"LU": true,
+	"LV": true,
+	"LY": true,
+	"MA": true,
+	"MC": true,
+	"MD": true,
+	"ME": true,
+	"MF": true,
+	"MG": true,
+	"MH": true,
+	"MK": true,
+	"ML": true,
+	"MM": true,
+	"MN": true,
+	"MO": true,
+	"MP": true,
+	"MQ": true,
+	"MR": true,
+	"MS": true,
+	"MT": true,
+	"MU": true,
+	"MV": true,
+	"MW": true,
+	"MX": true,
+	"MY": true,
+	"MZ": true,
+	"NA": true,
+	"NC": true,
+	"NE": true,
+	"NF": true,
+	"NG": true,
+	"NI": true,
+	"NL": true,
+	"NO": true,
+	"NP": true,
+	"NR": true,
+	"NU": true,
+	"NZ": true,
+	"OM": true,
+	"PA": true,
+	"PE": true,
+	"PF": true,
+	"PG": true,
+	"PH": true,
+	"PK": true,
+	"PL": true,
+	"PM": true,
+	"PN": true,
+	"PR": true,
+	"PS": true,
+	"PT": true,
+	"PW": true,
+	"PY": true,
+	"QA": true,
+	"RE": true,
+	"RO": true,
+	"RS": true,
+	"RU": true,
+	"RW": true,
+	"SA": true,
+	"SB": true,
+	"SC": true,
+	"SD": true,
+	"SE": true,
+	"SG": true,
+	"SH": true,
+	"SI": true,
+	"SJ": true,
+	"SK": true,
+	"SL": true,
+	"SM": true,
+	"SN": true,
+	"SO": true,
+	"SR": true,
+	"SS": true,
+	"ST": true,
+	"SV": true,
+	"SX": true,
+	"SY": true,
+	"SZ": true,
+	"TC": true,
+	"TD": true,
+	"TF": true,
+	"TG": true,
+	"TH": true,
+	"TJ": true,
+	"TK": true,
+	"TL": true,
+	"TM": true,
+	"TN": true,
+	"TO": true,
+	"TR": true,
+	"TT": true,
+	"TV": true,
+	"TW": true,
+	"TZ": true,
+	"UA": true,
+	"UG": true,
+	"UM": true,
+	"US": true,
+	"UY": true,
+	"UZ": true,
+	"VA": true,
+	"VC": true,
+	"VE": true,
+	"VG": true,
+	"VI": true,
+	"VN": true,
+	"VU": true,
+	"WF": true,
+	"WS": true,
+	"YE": true,
+	"YT": true,
+	"ZA": true,
+	"ZM": true,
+	"ZW": true,
+}
+
 // ValidateCurrencyCode checks if a currency string is valid according to https://en.wikipedia.org/wiki/ISO_4217
 func ValidateCurrencyCode(val interface{}, key string) (warns []string, errs []error) {
 	currency := val.(string)
@@ -309,6 +572,248 @@ func ValidateCurrencyCode(val interface{}, key string) (warns []string, errs []e
 	return
 }
 
+// ValidateCountryCode checks if a country string is valid according to https://en.wikipedia.org/wiki/ISO_3166-1_alpha-2
+func ValidateCountryCode(val interface{}, key string) (warns []string, errs []error) {
+	country := val.(string)
+	if _, exists := countryCodes[country]; !exists {
+		errs = append(errs, fmt.Errorf("%q unknown country code, must be valid ISO 3166-1 alpha-2 code, got: %s", key, country))
+	}
+	return
+}
+
+// validateLocations checks the countries and states of locations during the
+// plan. When validate_project_countries is enabled in the provider the
+// countries must also be configured in the project.
+func validateLocations(m interface{}, locations []commercetools.Location) error {
+	var projectCountries []string
+	for _, location := range locations {
+		country := string(location.Country)
+		if location.State != "" {
+			if err := validateSubdivision(country, location.State); err != nil {
+				return err
+			}
+		}
+
+		if !m.(*providerConfig).validateProjectCountries {
+			continue
+		}
+		if projectCountries == nil {
+			project, err := readProject(m)
+			if err != nil {
+				return err
+			}
+			projectCountries = make([]string, len(project.Countries))
+			for i, projectCountry := range project.Countries {
+				projectCountries[i] = string(projectCountry)
+			}
+		}
+		if !stringInSlice(country, projectCountries) {
+			return fmt.Errorf(
+				"country %s is not configured in the project, the project countries are: %s",
+				country, strings.Join(projectCountries, ", "))
+		}
+	}
+	return nil
+}
+
+// validateSubdivision checks if a state is a subdivision of the country
+// according to https://en.wikipedia.org/wiki/ISO_3166-2. The state can be the
+// code of the subdivision, with or without the country prefix, for example
+// US-NV or NV, or its ISO or English name, for example Bayern or Bavaria.
+func validateSubdivision(country string, state string) error {
+	code := strings.TrimPrefix(state, country+"-")
+	for _, subdivision := range subdivisions[country] {
+		if subdivision.code == code ||
+			strings.EqualFold(subdivision.name, state) ||
+			(subdivision.englishName != "" && strings.EqualFold(subdivision.englishName, state)) {
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown state %s for country %s, must be a valid ISO 3166-2 subdivision code or name", state, country)
+}
+
 func expandDate(input string) (time.Time, error) {
 	return time.Parse(time.RFC3339, input)
 }
+
+// flattenTypedMoney converts a money value to the currency_code/cent_amount
+// structure used in the schemas
+func flattenTypedMoney(money commercetools.TypedMoney) map[string]interface{} {
+	switch typedMoney := money.(type) {
+	case commercetools.CentPrecisionMoney:
+		return map[string]interface{}{
+			"currency_code": string(typedMoney.CurrencyCode),
+			"cent_amount":   typedMoney.CentAmount,
+		}
+	case commercetools.HighPrecisionMoney:
+		return map[string]interface{}{
+			"currency_code": string(typedMoney.CurrencyCode),
+			"cent_amount":   typedMoney.CentAmount,
+		}
+	}
+	return nil
+}
+
+// diffSuppressDate ignores differences in the formatting of equal dates, for
+// example a missing fraction of seconds.
+func diffSuppressDate(k, old, new string, d *schema.ResourceData) bool {
+	oldDate, err := expandDate(old)
+	if err != nil {
+		return false
+	}
+	newDate, err := expandDate(new)
+	if err != nil {
+		return false
+	}
+	return oldDate.Equal(newDate)
+}
+
+// diffSuppressJSON suppresses the difference between two JSON values which
+// only differ in formatting or key order
+func diffSuppressJSON(k, old, new string, d *schema.ResourceData) bool {
+	var oldValue, newValue interface{}
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
+
+func flattenDate(input *time.Time) string {
+	if input == nil {
+		return ""
+	}
+	return input.Format(time.RFC3339)
+}
+
+// customFieldsSchema returns the schema of the `custom` block used by resources
+// which support custom fields. The field values are JSON encoded strings.
+func customFieldsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"fields": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+					// The values are read back as JSON encoded by Go, which
+					// can differ in formatting from the configured value
+					DiffSuppressFunc: diffSuppressJSON,
+				},
+			},
+		},
+	}
+}
+
+func expandCustomFieldsDraft(d *schema.ResourceData) (*commercetools.CustomFieldsDraft, error) {
+	input := d.Get("custom").([]interface{})
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+	custom := input[0].(map[string]interface{})
+
+	fields, err := expandCustomFieldValues(custom["fields"].(map[string]interface{}))
+	if err != nil {
+		return nil, err
+	}
+
+	return &commercetools.CustomFieldsDraft{
+		Type:   &commercetools.TypeResourceIdentifier{ID: custom["type_id"].(string)},
+		Fields: fields,
+	}, nil
+}
+
+func expandCustomFieldValues(input map[string]interface{}) (*commercetools.FieldContainer, error) {
+	fields := make(commercetools.FieldContainer, len(input))
+	for name, raw := range input {
+		value, err := expandCustomFieldValue(name, raw.(string))
+		if err != nil {
+			return nil, err
+		}
+		fields[name] = value
+	}
+	return &fields, nil
+}
+
+func expandCustomFieldValue(name string, input string) (interface{}, error) {
+	var value interface{}
+	if err := json.Unmarshal([]byte(input), &value); err != nil {
+		return nil, fmt.Errorf("Value of custom field %s is not valid JSON: %s", name, err)
+	}
+	return value, nil
+}
+
+func flattenCustomFields(custom *commercetools.CustomFields) ([]map[string]interface{}, error) {
+	if custom == nil || custom.Type == nil {
+		return []map[string]interface{}{}, nil
+	}
+
+	fields := make(map[string]string)
+	if custom.Fields != nil {
+		for name, value := range *custom.Fields {
+			data, err := json.Marshal(value)
+			if err != nil {
+				return nil, err
+			}
+			fields[name] = string(data)
+		}
+	}
+
+	return []map[string]interface{}{
+		{
+			"type_id": custom.Type.ID,
+			"fields":  fields,
+		},
+	}, nil
+}
+
+// customFieldsTypeChanged returns true when the custom type is added, removed
+// or changed, in which case all the fields need to be set again.
+func customFieldsTypeChanged(d *schema.ResourceData) bool {
+	old, new := d.GetChange("custom")
+	oldCustom := old.([]interface{})
+	newCustom := new.([]interface{})
+
+	if len(oldCustom) != len(newCustom) {
+		return true
+	}
+	if len(newCustom) == 0 {
+		return false
+	}
+	return oldCustom[0].(map[string]interface{})["type_id"] != newCustom[0].(map[string]interface{})["type_id"]
+}
+
+// customFieldValueChanges returns the custom fields which need to be updated,
+// removed fields have a nil value.
+func customFieldValueChanges(d *schema.ResourceData) (map[string]interface{}, error) {
+	old, new := d.GetChange("custom.0.fields")
+	oldFields := old.(map[string]interface{})
+	newFields := new.(map[string]interface{})
+
+	result := make(map[string]interface{})
+	for name, raw := range newFields {
+		if oldValue, ok := oldFields[name]; ok && oldValue == raw {
+			continue
+		}
+		value, err := expandCustomFieldValue(name, raw.(string))
+		if err != nil {
+			return nil, err
+		}
+		result[name] = value
+	}
+	for name := range oldFields {
+		if _, ok := newFields[name]; !ok {
+			result[name] = nil
+		}
+	}
+	return result, nil
+}
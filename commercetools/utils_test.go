@@ -1,6 +1,14 @@
 package commercetools
 
-import "testing"
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labd/commercetools-go-sdk/commercetools"
+	"github.com/stretchr/testify/assert"
+)
 
 func TestCreateLookup(t *testing.T) {
 	input := []interface{}{
@@ -21,3 +29,81 @@ func TestCreateLookup(t *testing.T) {
 		t.Error("Could not lookup name1")
 	}
 }
+
+func TestExpandCustomFieldValue(t *testing.T) {
+	value, err := expandCustomFieldValue("rank", "10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != float64(10) {
+		t.Errorf("Expected 10, got %#v", value)
+	}
+
+	value, err = expandCustomFieldValue("label", `"foobar"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value != "foobar" {
+		t.Errorf("Expected foobar, got %#v", value)
+	}
+
+	_, err = expandCustomFieldValue("label", "foobar")
+	if err == nil {
+		t.Error("Expected an error for a value which is not valid JSON")
+	}
+}
+
+func TestValidateCountryCode(t *testing.T) {
+	_, errs := ValidateCountryCode("GB", "country")
+	assert.Empty(t, errs)
+
+	_, errs = ValidateCountryCode("UK", "country")
+	assert.Len(t, errs, 1)
+}
+
+func TestValidateSubdivision(t *testing.T) {
+	assert.NoError(t, validateSubdivision("US", "US-NV"))
+	assert.NoError(t, validateSubdivision("US", "NV"))
+	assert.NoError(t, validateSubdivision("US", "Nevada"))
+	assert.NoError(t, validateSubdivision("DE", "Bayern"))
+
+	assert.NoError(t, validateSubdivision("DE", "Bavaria"))
+	assert.NoError(t, validateSubdivision("AE", "Dubai"))
+
+	assert.Error(t, validateSubdivision("US", "Nevda"))
+	assert.Error(t, validateSubdivision("US", "XX"))
+	assert.Error(t, validateSubdivision("US", "DE-BY"))
+	assert.Error(t, validateSubdivision("NL", "Nevada"))
+}
+
+func TestValidateLocationsProjectCountries(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"key": "project", "version": 1, "countries": ["NL", "DE"]}`)
+	}))
+	defer server.Close()
+
+	config := &providerConfig{
+		rest:  newRestClient(server.Client(), server.URL, "project"),
+		cache: newReadCache(),
+	}
+
+	locations := []commercetools.Location{{Country: "NL"}, {Country: "BE"}}
+	assert.NoError(t, validateLocations(config, locations))
+	assert.Equal(t, 0, requests)
+
+	config.validateProjectCountries = true
+	assert.EqualError(t, validateLocations(config, locations),
+		"country BE is not configured in the project, the project countries are: NL, DE")
+	assert.NoError(t, validateLocations(config, []commercetools.Location{{Country: "DE", State: "Bayern"}}))
+	assert.Equal(t, 1, requests)
+}
+
+func TestDiffSuppressJSON(t *testing.T) {
+	assert.True(t, diffSuppressJSON("fields.label", `{"nl": "Rood", "en": "Red"}`, `{"en":"Red","nl":"Rood"}`, nil))
+	assert.True(t, diffSuppressJSON("fields.rank", `10`, ` 10 `, nil))
+	assert.False(t, diffSuppressJSON("fields.label", `{"en": "Red"}`, `{"en": "Blue"}`, nil))
+	assert.False(t, diffSuppressJSON("fields.label", `"red"`, `red`, nil))
+}